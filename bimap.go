@@ -0,0 +1,119 @@
+package threadsafe
+
+import "sync"
+
+// BiMap represents a generic one-to-one map that can be looked up in either direction. Every key maps to exactly one
+// value and every value to exactly one key, so setting a pair replaces any existing pair sharing its key or its value.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+	lock    sync.RWMutex
+}
+
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	v, ok := m.forward[key]
+
+	return v, ok
+}
+
+// GetByValue returns the key K for value V. Also returns a boolean representing if the key was found or not.
+func (m *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	k, ok := m.inverse[value]
+
+	return k, ok
+}
+
+// Set maps key K to value V in both directions. Any existing pair using K or V is removed first.
+func (m *BiMap[K, V]) Set(key K, value V) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if v, ok := m.forward[key]; ok {
+		delete(m.inverse, v)
+	}
+	if k, ok := m.inverse[value]; ok {
+		delete(m.forward, k)
+	}
+
+	m.forward[key] = value
+	m.inverse[value] = key
+}
+
+// Delete deletes the pair with key K, if it exists.
+func (m *BiMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if v, ok := m.forward[key]; ok {
+		delete(m.forward, key)
+		delete(m.inverse, v)
+	}
+}
+
+// DeleteByValue deletes the pair with value V, if it exists.
+func (m *BiMap[K, V]) DeleteByValue(value V) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if k, ok := m.inverse[value]; ok {
+		delete(m.inverse, value)
+		delete(m.forward, k)
+	}
+}
+
+// Keys returns a slice of K keys.
+func (m *BiMap[K, V]) Keys() []K {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]K, 0, len(m.forward))
+	for k := range m.forward {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Values returns a slice of V values.
+func (m *BiMap[K, V]) Values() []V {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	values := make([]V, 0, len(m.inverse))
+	for v := range m.inverse {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Empty deletes all pairs in the map.
+func (m *BiMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.forward = make(map[K]V)
+	m.inverse = make(map[V]K)
+}
+
+// Len returns the number of pairs in the map.
+func (m *BiMap[K, V]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.forward)
+}
@@ -0,0 +1,129 @@
+package threadsafe
+
+import (
+	"math/bits"
+	"sync/atomic"
+)
+
+// Bitset represents a fixed-size set of bits packed into 64-bit words. Every operation works on whole words with
+// atomic instructions, so a Bitset never locks and single-bit operations never block each other. Operations spanning
+// several words, such as Count or Or, are atomic per word only and do not see a consistent view of the whole set while
+// it is being changed concurrently.
+type Bitset struct {
+	words []atomic.Uint64
+	size  int
+}
+
+// NewBitset returns a Bitset holding size bits, all cleared.
+func NewBitset(size int) *Bitset {
+	return &Bitset{
+		words: make([]atomic.Uint64, (size+63)/64),
+		size:  size,
+	}
+}
+
+// Set sets bit i and reports whether it was already set. Set will panic if i is out of bounds.
+func (b *Bitset) Set(i int) bool {
+	w, mask := b.word(i)
+
+	return w.Or(mask)&mask != 0
+}
+
+// Clear clears bit i and reports whether it was set. Clear will panic if i is out of bounds.
+func (b *Bitset) Clear(i int) bool {
+	w, mask := b.word(i)
+
+	return w.And(^mask)&mask != 0
+}
+
+// Test reports whether bit i is set. Test will panic if i is out of bounds.
+func (b *Bitset) Test(i int) bool {
+	w, mask := b.word(i)
+
+	return w.Load()&mask != 0
+}
+
+// Flip inverts bit i and reports whether it is now set. Flip will panic if i is out of bounds.
+func (b *Bitset) Flip(i int) bool {
+	w, mask := b.word(i)
+
+	for {
+		old := w.Load()
+		if w.CompareAndSwap(old, old^mask) {
+			return old&mask == 0
+		}
+	}
+}
+
+// And clears every bit of b that is not set in other. Bits beyond the size of other count as cleared.
+func (b *Bitset) And(other *Bitset) {
+	for i := range b.words {
+		var v uint64
+		if i < len(other.words) {
+			v = other.words[i].Load()
+		}
+		b.words[i].And(v)
+	}
+}
+
+// Or sets every bit of b that is set in other. Bits of other beyond the size of b are ignored.
+func (b *Bitset) Or(other *Bitset) {
+	n := min(len(b.words), len(other.words))
+	for i := range n {
+		b.words[i].Or(other.words[i].Load() & b.mask(i))
+	}
+}
+
+// Xor inverts every bit of b that is set in other. Bits of other beyond the size of b are ignored.
+func (b *Bitset) Xor(other *Bitset) {
+	n := min(len(b.words), len(other.words))
+	for i := range n {
+		v := other.words[i].Load() & b.mask(i)
+		for {
+			old := b.words[i].Load()
+			if b.words[i].CompareAndSwap(old, old^v) {
+				break
+			}
+		}
+	}
+}
+
+// Count returns the number of set bits.
+func (b *Bitset) Count() int {
+	n := 0
+	for i := range b.words {
+		n += bits.OnesCount64(b.words[i].Load())
+	}
+
+	return n
+}
+
+// Reset clears every bit.
+func (b *Bitset) Reset() {
+	for i := range b.words {
+		b.words[i].Store(0)
+	}
+}
+
+// Len returns the number of bits in the Bitset.
+func (b *Bitset) Len() int {
+	return b.size
+}
+
+// word returns the word holding bit i and the mask selecting it within that word.
+func (b *Bitset) word(i int) (*atomic.Uint64, uint64) {
+	if i < 0 || i >= b.size {
+		panic("threadsafe: Bitset index out of range")
+	}
+
+	return &b.words[i/64], 1 << (i % 64)
+}
+
+// mask returns the mask of the bits of word i that lie within the size of b.
+func (b *Bitset) mask(i int) uint64 {
+	if rem := b.size - i*64; rem < 64 {
+		return 1<<rem - 1
+	}
+
+	return ^uint64(0)
+}
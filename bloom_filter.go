@@ -0,0 +1,109 @@
+package threadsafe
+
+import (
+	"hash/maphash"
+	"math"
+	"math/cmplx"
+	"reflect"
+)
+
+// bloomSeeds are shared by every BloomFilter in the process, so that filters built with the same parameters hash
+// values identically and can be merged.
+var bloomSeeds = [2]maphash.Seed{maphash.MakeSeed(), maphash.MakeSeed()}
+
+// BloomFilter represents a generic probabilistic set. MaybeContains never reports false for a value that was added,
+// but may report true for one that was not, at roughly the false positive rate the filter was sized for. Values cannot
+// be removed. The bits are kept in a Bitset, so a BloomFilter never locks. Hashes come from hash/maphash and are only
+// stable within a single process.
+//
+// All float and complex NaN values are treated as one value, so an added NaN is always found. NaNs nested inside
+// structs, arrays or interfaces are not normalized and, as they hash differently every time, are never reliably found.
+type BloomFilter[T comparable] struct {
+	bits *Bitset
+	k    int
+}
+
+// NewBloomFilter returns a BloomFilter sized to hold n values with a false positive rate of at most p. NewBloomFilter
+// will panic if n is less than 1 or p is not between 0 and 1, exclusive.
+func NewBloomFilter[T comparable](n int, p float64) *BloomFilter[T] {
+	if n < 1 {
+		panic("threadsafe: BloomFilter capacity must be at least 1")
+	}
+	if p <= 0 || p >= 1 {
+		panic("threadsafe: BloomFilter false positive rate must be between 0 and 1")
+	}
+
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := max(1, int(math.Round(m/float64(n)*math.Ln2)))
+
+	return &BloomFilter[T]{
+		bits: NewBitset(int(m)),
+		k:    k,
+	}
+}
+
+// Add adds v to the filter.
+func (f *BloomFilter[T]) Add(v T) {
+	h1, h2 := bloomHash(v)
+	for i := range f.k {
+		f.bits.Set(f.index(h1, h2, i))
+	}
+}
+
+// MaybeContains reports whether v may have been added to the filter. A false result means v was definitely never
+// added.
+func (f *BloomFilter[T]) MaybeContains(v T) bool {
+	h1, h2 := bloomHash(v)
+	for i := range f.k {
+		if !f.bits.Test(f.index(h1, h2, i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Merge adds every value of other to f. Both filters must have been created with the same n and p; Merge returns
+// false and leaves f unchanged if they were not.
+func (f *BloomFilter[T]) Merge(other *BloomFilter[T]) bool {
+	if f.k != other.k || f.bits.Len() != other.bits.Len() {
+		return false
+	}
+
+	f.bits.Or(other.bits)
+
+	return true
+}
+
+// Reset removes every value from the filter.
+func (f *BloomFilter[T]) Reset() {
+	f.bits.Reset()
+}
+
+// index returns the bit for the i-th hash function, derived from h1 and h2 by double hashing.
+func (f *BloomFilter[T]) index(h1, h2 uint64, i int) int {
+	return int((h1 + uint64(i)*h2) % uint64(f.bits.Len()))
+}
+
+// bloomNaN stands in for every NaN when hashing, as maphash gives each NaN a random hash.
+type bloomNaN struct{}
+
+func bloomHash[T comparable](v T) (uint64, uint64) {
+	if isNaN(v) {
+		return maphash.Comparable(bloomSeeds[0], bloomNaN{}), maphash.Comparable(bloomSeeds[1], bloomNaN{})
+	}
+
+	return maphash.Comparable(bloomSeeds[0], v), maphash.Comparable(bloomSeeds[1], v)
+}
+
+// isNaN reports whether v is a float or complex NaN, including values of named float types.
+func isNaN[T comparable](v T) bool {
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.IsNaN(rv.Float())
+	case reflect.Complex64, reflect.Complex128:
+		return cmplx.IsNaN(rv.Complex())
+	default:
+		return false
+	}
+}
@@ -0,0 +1,49 @@
+package threadsafe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBloomFilterNaN(t *testing.T) {
+	type score float64
+
+	f := NewBloomFilter[float64](100, 0.01)
+	f.Add(math.NaN())
+	g := NewBloomFilter[score](100, 0.01)
+	g.Add(score(math.NaN()))
+	h := NewBloomFilter[any](100, 0.01)
+	h.Add(math.NaN())
+
+	for range 100 {
+		if !f.MaybeContains(math.NaN()) {
+			t.Fatal("MaybeContains(NaN) = false after Add(NaN)")
+		}
+		if !g.MaybeContains(score(math.NaN())) {
+			t.Fatal("MaybeContains(score(NaN)) = false after Add(score(NaN))")
+		}
+		if !h.MaybeContains(math.NaN()) {
+			t.Fatal("MaybeContains(any(NaN)) = false after Add(any(NaN))")
+		}
+	}
+}
+
+func TestBloomFilterNoFalseNegatives(t *testing.T) {
+	f := NewBloomFilter[int](1000, 0.01)
+	for i := range 1000 {
+		f.Add(i)
+	}
+
+	fp := 0
+	for i := range 1000 {
+		if !f.MaybeContains(i) {
+			t.Fatalf("MaybeContains(%d) = false after Add", i)
+		}
+		if f.MaybeContains(-1 - i) {
+			fp++
+		}
+	}
+	if fp > 50 {
+		t.Errorf("%d false positives out of 1000, want about 10", fp)
+	}
+}
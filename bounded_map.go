@@ -0,0 +1,59 @@
+package threadsafe
+
+// EvictionPolicy selects which entry a BoundedMap evicts when it is full.
+type EvictionPolicy int
+
+const (
+	// LRU evicts the least recently used entry.
+	LRU EvictionPolicy = iota
+	// LFU evicts the least frequently used entry.
+	LFU
+)
+
+// EvictionReason describes why an entry was removed from a map without being explicitly deleted.
+type EvictionReason int
+
+const (
+	// EvictedCapacity means the entry was evicted to make room for a new one.
+	EvictedCapacity EvictionReason = iota
+	// EvictedExpired means the entry's time-to-live elapsed.
+	EvictedExpired
+)
+
+// String returns the name of the reason.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "capacity"
+	case EvictedExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// BoundedMap is the method set shared by the size-bounded maps, LRUMap and LFUMap.
+type BoundedMap[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Set(key K, value V)
+	Delete(key K)
+	Keys() []K
+	Empty()
+	Len() int
+	Capacity() int
+	OnEvict(f func(key K, value V, reason EvictionReason))
+}
+
+// NewBoundedMap returns a map holding at most capacity entries, using the given eviction policy. NewBoundedMap panics
+// if capacity is less than 1 or the policy is unknown.
+func NewBoundedMap[K comparable, V any](capacity int, policy EvictionPolicy) BoundedMap[K, V] {
+	switch policy {
+	case LRU:
+		return NewLRUMap[K, V](capacity)
+	case LFU:
+		return NewLFUMap[K, V](capacity)
+	default:
+		panic("threadsafe: unknown EvictionPolicy")
+	}
+}
@@ -0,0 +1,33 @@
+package threadsafe
+
+// Cloner is implemented by values that can make a deep copy of themselves.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// SetDeepCopy controls whether values leaving the map are deep copied. When enabled, values implementing Cloner[V] are
+// replaced by the result of Clone wherever the map hands them out after its lock is released: every method returning
+// a value, including DefaultMap.Get and MapTx.Get, the SortedItems and GroupMapBy functions, the Range callback and
+// the All and ValuesSeq iterators, watch events and OnSet hooks. Callers therefore can't mutate a value stored in the map outside of its lock. Values that
+// don't implement Cloner[V] are returned as-is. Deep copying is disabled by default.
+//
+// Callbacks that only run while the lock is held, such as the predicates of Find, Any, Every, Filter and DeleteFunc,
+// the functions passed to Compute, TransformValues, Merge, Equal, CompareAndSwapFunc and WithLock, receive stored
+// values directly and must not retain them. Drain, OnDelete and OnEmpty hand over values the map no longer holds and
+// do not copy them, and the encoders read stored values without copying as they only produce bytes.
+func (m *Map[K, V]) SetDeepCopy(enabled bool) {
+	m.deepCopy.Store(enabled)
+}
+
+// copyValue returns a deep copy of v if deep copying is enabled and v implements Cloner[V], otherwise v itself.
+func (m *Map[K, V]) copyValue(v V) V {
+	if !m.deepCopy.Load() {
+		return v
+	}
+
+	if c, ok := any(v).(Cloner[V]); ok {
+		return c.Clone()
+	}
+
+	return v
+}
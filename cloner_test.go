@@ -0,0 +1,66 @@
+package threadsafe
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type counterBox struct {
+	n *int
+}
+
+func (b counterBox) Clone() counterBox {
+	n := *b.n
+	return counterBox{n: &n}
+}
+
+// TestDeepCopyUnderLock runs each value-returning read alongside in-place writes made under the lock. With -race, a
+// Clone made after the lock was released is reported as a data race.
+func TestDeepCopyUnderLock(t *testing.T) {
+	reads := map[string]func(m *DefaultMap[string, counterBox]) counterBox{
+		"DefaultMap.Get": func(m *DefaultMap[string, counterBox]) counterBox {
+			return m.Get("a")
+		},
+		"GetWait": func(m *DefaultMap[string, counterBox]) counterBox {
+			v, _ := m.GetWait(context.Background(), "a")
+			return v
+		},
+		"Compute": func(m *DefaultMap[string, counterBox]) counterBox {
+			v, _ := m.Compute("a", func(old counterBox, _ bool) (counterBox, bool) { return old, true })
+			return v
+		},
+		"DoKey": func(m *DefaultMap[string, counterBox]) counterBox {
+			v, _ := m.DoKey("a", func(old counterBox, _ bool) (counterBox, bool) { return old, true })
+			return v
+		},
+	}
+
+	for name, read := range reads {
+		t.Run(name, func(t *testing.T) {
+			m := NewDefaultMap(func(string) counterBox { return counterBox{n: new(int)} })
+			m.SetDeepCopy(true)
+			m.Get("a")
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					m.WithLock(func(data map[string]counterBox) {
+						*data["a"].n++
+					})
+				}
+			}()
+
+			for range 100 {
+				*read(m).n = -1
+			}
+			wg.Wait()
+
+			if n := *m.Get("a").n; n != 100 {
+				t.Errorf("stored value = %d, want 100", n)
+			}
+		})
+	}
+}
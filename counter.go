@@ -0,0 +1,119 @@
+package threadsafe
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+)
+
+// Counter represents a generic count per key, along with the running total of all counts.
+type Counter[K comparable] struct {
+	counts map[K]int64
+	total  int64
+	lock   sync.RWMutex
+}
+
+// CounterItem is a single key and its count, as returned by Counter.TopN.
+type CounterItem[K comparable] struct {
+	Key   K
+	Count int64
+}
+
+func NewCounter[K comparable]() *Counter[K] {
+	return &Counter[K]{
+		counts: make(map[K]int64),
+	}
+}
+
+// Incr adds one to the count at key K and returns the new count.
+func (c *Counter[K]) Incr(key K) int64 {
+	return c.Add(key, 1)
+}
+
+// Decr subtracts one from the count at key K and returns the new count.
+func (c *Counter[K]) Decr(key K) int64 {
+	return c.Add(key, -1)
+}
+
+// Add adds n to the count at key K and returns the new count. Missing keys start at zero.
+func (c *Counter[K]) Add(key K, n int64) int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.counts[key] += n
+	c.total += n
+
+	return c.counts[key]
+}
+
+// Get returns the count at key K, or zero if the key has not been counted.
+func (c *Counter[K]) Get(key K) int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.counts[key]
+}
+
+// Delete deletes the key K and removes its count from the total.
+func (c *Counter[K]) Delete(key K) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.total -= c.counts[key]
+	delete(c.counts, key)
+}
+
+// Total returns the sum of all counts.
+func (c *Counter[K]) Total() int64 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.total
+}
+
+// TopN returns the n keys with the highest counts, highest first. Keys with equal counts are returned in an
+// unspecified order. If n exceeds the number of keys, all keys are returned.
+func (c *Counter[K]) TopN(n int) []CounterItem[K] {
+	c.lock.RLock()
+	items := make([]CounterItem[K], 0, len(c.counts))
+	for k, v := range c.counts {
+		items = append(items, CounterItem[K]{Key: k, Count: v})
+	}
+	c.lock.RUnlock()
+
+	slices.SortFunc(items, func(a, b CounterItem[K]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	return items[:max(0, min(n, len(items)))]
+}
+
+// Keys returns a slice of K keys.
+func (c *Counter[K]) Keys() []K {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	keys := make([]K, 0, len(c.counts))
+	for k := range c.counts {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Empty deletes all keys and resets the total to zero.
+func (c *Counter[K]) Empty() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.counts = make(map[K]int64)
+	c.total = 0
+}
+
+// Len returns the number of keys being counted.
+func (c *Counter[K]) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return len(c.counts)
+}
@@ -0,0 +1,118 @@
+package threadsafe
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// COWSlice represents a generic copy-on-write slice. Reads load an immutable slice through an atomic pointer and never
+// block, while every write copies the current slice, modifies the copy and swaps it in. This suits data that is read
+// constantly but changed rarely, such as configuration or routing tables. The zero value is an empty slice ready to
+// use.
+type COWSlice[T any] struct {
+	data atomic.Pointer[[]T]
+	lock sync.Mutex
+}
+
+// NewCOWSliceFrom returns a COWSlice containing a copy of data.
+func NewCOWSliceFrom[T any](data []T) *COWSlice[T] {
+	s := &COWSlice[T]{}
+	s.Store(data)
+
+	return s
+}
+
+// Load returns the current contents. The returned slice is shared with other readers and must not be modified; use
+// Snapshot for a private copy.
+func (s *COWSlice[T]) Load() []T {
+	if p := s.data.Load(); p != nil {
+		return *p
+	}
+
+	return nil
+}
+
+// Snapshot returns a copy of the current contents that can be modified freely.
+func (s *COWSlice[T]) Snapshot() []T {
+	return slices.Clone(s.Load())
+}
+
+// Get returns the element at index. Get will panic if index is out of bounds. If a panic is undesired, use SafeGet.
+func (s *COWSlice[T]) Get(index int) T {
+	return s.Load()[index]
+}
+
+// SafeGet returns the element at index. The boolean is false if index is out of bounds.
+func (s *COWSlice[T]) SafeGet(index int) (T, bool) {
+	data := s.Load()
+	if index < 0 || index >= len(data) {
+		return *new(T), false
+	}
+
+	return data[index], true
+}
+
+// Range calls f sequentially for each index and element. If f returns false, Range stops the iteration. Range iterates
+// the contents as they were when it was called and never blocks writers.
+func (s *COWSlice[T]) Range(f func(i int, v T) bool) {
+	for i, v := range s.Load() {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// Len returns the length of the slice.
+func (s *COWSlice[T]) Len() int {
+	return len(s.Load())
+}
+
+// Store replaces the contents with a copy of data.
+func (s *COWSlice[T]) Store(data []T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	data = slices.Clone(data)
+	s.data.Store(&data)
+}
+
+// Append appends the values v.
+func (s *COWSlice[T]) Append(v ...T) {
+	s.update(func(data []T) []T {
+		return append(data, v...)
+	})
+}
+
+// Replace replaces the element at index with v. Replace will panic if index is out of bounds.
+func (s *COWSlice[T]) Replace(index int, v T) {
+	s.update(func(data []T) []T {
+		data[index] = v
+		return data
+	})
+}
+
+// Delete deletes the element at index. Delete will panic if index is out of bounds.
+func (s *COWSlice[T]) Delete(index int) {
+	s.update(func(data []T) []T {
+		return slices.Delete(data, index, index+1)
+	})
+}
+
+// Empty deletes all elements.
+func (s *COWSlice[T]) Empty() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data.Store(nil)
+}
+
+// update copies the current contents, applies f to the copy and stores the result. Writers are serialized so that no
+// update is lost.
+func (s *COWSlice[T]) update(f func(data []T) []T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	data := f(slices.Clone(s.Load()))
+	s.data.Store(&data)
+}
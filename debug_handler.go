@@ -0,0 +1,109 @@
+package threadsafe
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// defaultDebugLimit is the page size DebugHandler uses when the request does not set one.
+	defaultDebugLimit = 100
+	// maxDebugLimit is the largest page size DebugHandler will serve.
+	maxDebugLimit = 1000
+)
+
+// Pageable is implemented by the collections that DebugHandler can serve, currently *Map and *Slice.
+type Pageable interface {
+	// page returns the total number of items and the items in [offset, offset+limit), ready to encode as JSON.
+	page(offset, limit int) (total int, items any)
+}
+
+type debugPage struct {
+	Total  int `json:"total"`
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+	Items  any `json:"items"`
+}
+
+type debugEntry[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// DebugHandler returns an http.Handler serving a read-only, paginated JSON view of c. The offset and limit query
+// parameters select the page; limit defaults to defaultDebugLimit and is capped at maxDebugLimit. Map entries are
+// served as key/value objects, ordered by the formatted key so that pages are stable.
+func DebugHandler(c Pageable) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		offset, err := queryInt(r, "offset", 0)
+		if err != nil || offset < 0 {
+			http.Error(w, "invalid offset", http.StatusBadRequest)
+			return
+		}
+
+		limit, err := queryInt(r, "limit", defaultDebugLimit)
+		if err != nil || limit < 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = min(limit, maxDebugLimit)
+
+		total, items := c.page(offset, limit)
+
+		b, err := json.Marshal(debugPage{Total: total, Offset: offset, Limit: limit, Items: items})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+}
+
+func queryInt(r *http.Request, name string, fallback int) (int, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return fallback, nil
+	}
+
+	return strconv.Atoi(s)
+}
+
+func (m *Map[K, V]) page(offset, limit int) (int, any) {
+	data := m.Snapshot()
+	keys := keysByName(data)
+
+	start, end := pageBounds(len(keys), offset, limit)
+
+	items := make([]debugEntry[K, V], 0, end-start)
+	for _, k := range keys[start:end] {
+		items = append(items, debugEntry[K, V]{Key: k, Value: data[k]})
+	}
+
+	return len(data), items
+}
+
+func (s *Slice[T]) page(offset, limit int) (int, any) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	start, end := pageBounds(len(s.Data), offset, limit)
+
+	return len(s.Data), append([]T{}, s.Data[start:end]...)
+}
+
+// pageBounds clamps the page [offset, offset+limit) to a collection of length n.
+func pageBounds(n, offset, limit int) (start, end int) {
+	start = min(offset, n)
+	end = min(start+limit, n)
+
+	return start, end
+}
@@ -0,0 +1,42 @@
+package threadsafe
+
+// DefaultMap represents a Map that creates a value for a missing key the first time it is read with Get, in the style
+// of Python's defaultdict. The factory runs under the map's lock, so exactly one default is created per key. All other
+// methods are those of the embedded Map and do not create defaults.
+type DefaultMap[K comparable, V any] struct {
+	Map[K, V]
+	factory func(K) V
+}
+
+// NewDefaultMap returns a DefaultMap that calls factory to create the value for each missing key. factory must not
+// call methods on the map, or it will deadlock.
+func NewDefaultMap[K comparable, V any](factory func(K) V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{
+		Map:     Map[K, V]{Data: make(map[K]V)},
+		factory: factory,
+	}
+}
+
+// Get returns the value V at key K. If the key does not exist, a default is created with the factory, stored and
+// returned.
+func (m *DefaultMap[K, V]) Get(key K) V {
+	m.lockRead()
+	if v, ok := m.Data[key]; ok {
+		v = m.copyValue(v)
+		m.lock.RUnlock()
+		return v
+	}
+	m.lock.RUnlock()
+
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	if v, ok := m.Data[key]; ok {
+		return m.copyValue(v)
+	}
+
+	v := m.factory(key)
+	m.set(key, v)
+
+	return m.copyValue(v)
+}
@@ -0,0 +1,173 @@
+package threadsafe
+
+import (
+	"sync"
+	"time"
+)
+
+// ExpiringSet represents a generic set whose members expire after a time-to-live. Like TTLMap, expired members are
+// removed lazily when they are accessed, and optionally by a background janitor goroutine. An ExpiringSet with a
+// janitor must be closed with Close to stop the goroutine.
+type ExpiringSet[T comparable] struct {
+	data    map[T]ttlEntry[struct{}]
+	ttl     time.Duration
+	lock    sync.Mutex
+	janitor janitor
+}
+
+// NewExpiringSet returns an ExpiringSet whose members expire ttl after they are added. A ttl <= 0 means members never
+// expire unless added with AddWithTTL.
+func NewExpiringSet[T comparable](ttl time.Duration) *ExpiringSet[T] {
+	return &ExpiringSet[T]{
+		data: make(map[T]ttlEntry[struct{}]),
+		ttl:  ttl,
+	}
+}
+
+// NewExpiringSetWithJanitor behaves like NewExpiringSet but also starts a goroutine that removes expired members every
+// interval. Close must be called to stop it. NewExpiringSetWithJanitor will panic if interval is not positive.
+func NewExpiringSetWithJanitor[T comparable](ttl time.Duration, interval time.Duration) *ExpiringSet[T] {
+	s := NewExpiringSet[T](ttl)
+	s.janitor.start(interval, func() { s.DeleteExpired() })
+
+	return s
+}
+
+// Add adds the values v to the set using the set's default ttl. Adding a value that is already present restarts its
+// ttl.
+func (s *ExpiringSet[T]) Add(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	e := s.newEntry(s.ttl)
+	for _, x := range v {
+		s.data[x] = e
+	}
+}
+
+// AddWithTTL adds the value v to the set, expiring after ttl. A ttl <= 0 means the member never expires.
+func (s *ExpiringSet[T]) AddWithTTL(v T, ttl time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data[v] = s.newEntry(ttl)
+}
+
+// AddIfAbsent adds the value v using the set's default ttl if it is not already an unexpired member. Returns true if v
+// was added. Checking and adding happen under one lock, so exactly one of several concurrent callers adding the same
+// value sees true, which makes AddIfAbsent suitable for deduplication windows.
+func (s *ExpiringSet[T]) AddIfAbsent(v T) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if e, ok := s.data[v]; ok && !e.expired(time.Now()) {
+		return false
+	}
+
+	s.data[v] = s.newEntry(s.ttl)
+
+	return true
+}
+
+// Contains reports whether v is an unexpired member of the set. An expired v is removed.
+func (s *ExpiringSet[T]) Contains(v T) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	e, ok := s.data[v]
+	if !ok {
+		return false
+	}
+
+	if e.expired(time.Now()) {
+		delete(s.data, v)
+		return false
+	}
+
+	return true
+}
+
+// Remove removes the values v from the set, if present.
+func (s *ExpiringSet[T]) Remove(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, x := range v {
+		delete(s.data, x)
+	}
+}
+
+// DeleteExpired removes every expired member. Returns the number of members removed.
+func (s *ExpiringSet[T]) DeleteExpired() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+
+	n := 0
+	for v, e := range s.data {
+		if e.expired(now) {
+			delete(s.data, v)
+			n++
+		}
+	}
+
+	return n
+}
+
+// ToSlice returns the unexpired members of the set in an unspecified order.
+func (s *ExpiringSet[T]) ToSlice() []T {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+
+	values := make([]T, 0, len(s.data))
+	for v, e := range s.data {
+		if !e.expired(now) {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// Empty removes every member of the set.
+func (s *ExpiringSet[T]) Empty() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data = make(map[T]ttlEntry[struct{}])
+}
+
+// Len returns the number of unexpired members in the set.
+func (s *ExpiringSet[T]) Len() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+
+	n := 0
+	for _, e := range s.data {
+		if !e.expired(now) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Close stops the janitor goroutine, if one was started. It is safe to call Close more than once. The set remains
+// usable after Close, relying on lazy expiration only.
+func (s *ExpiringSet[T]) Close() {
+	s.janitor.stop()
+}
+
+func (s *ExpiringSet[T]) newEntry(ttl time.Duration) ttlEntry[struct{}] {
+	var e ttlEntry[struct{}]
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+
+	return e
+}
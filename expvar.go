@@ -0,0 +1,58 @@
+package threadsafe
+
+import (
+	"encoding/json"
+	"expvar"
+)
+
+// maxPublishEntries is the maximum number of entries or elements exposed by Publish.
+const maxPublishEntries = 100
+
+// published is the JSON value exposed through expvar for a Map or Slice.
+type published struct {
+	Len  int             `json:"len"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// newPublished returns the published value for a collection of length n. data is omitted if it cannot be encoded as
+// JSON, so that /debug/vars always remains valid.
+func newPublished(n int, data any) published {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return published{Len: n}
+	}
+
+	return published{Len: n, Data: b}
+}
+
+// Publish exposes the map under name in expvar, and so under /debug/vars. The published value is an object holding the
+// map's length and up to maxPublishEntries of its entries, read each time the variable is requested. Like
+// expvar.Publish, it panics if name is already in use.
+func (m *Map[K, V]) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		m.lockRead()
+		defer m.lock.RUnlock()
+
+		data := make(map[K]V, min(len(m.Data), maxPublishEntries))
+		for k, v := range m.Data {
+			if len(data) == maxPublishEntries {
+				break
+			}
+			data[k] = v
+		}
+
+		return newPublished(len(m.Data), data)
+	}))
+}
+
+// Publish exposes the slice under name in expvar, and so under /debug/vars. The published value is an object holding
+// the slice's length and up to its first maxPublishEntries elements, read each time the variable is requested. Like
+// expvar.Publish, it panics if name is already in use.
+func (s *Slice[T]) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		s.lock.RLock()
+		defer s.lock.RUnlock()
+
+		return newPublished(len(s.Data), s.Data[:min(len(s.Data), maxPublishEntries)])
+	}))
+}
@@ -1,3 +1,3 @@
 module github.com/eolso/threadsafe
 
-go 1.20
+go 1.24
@@ -0,0 +1,47 @@
+package threadsafe
+
+import (
+	"sync"
+	"time"
+)
+
+// janitor runs a cleanup function every interval in a background goroutine until it is stopped. It is shared by the
+// types that expire entries, such as TTLMap and ExpiringSet. The zero value has no goroutine and stops as a no-op.
+type janitor struct {
+	done chan struct{}
+	once sync.Once
+}
+
+// start starts the goroutine calling f every interval. It panics if interval is not positive, so that the mistake
+// surfaces in the caller rather than crashing the process from inside the goroutine. start must be called at most
+// once, before the janitor is shared.
+func (j *janitor) start(interval time.Duration, f func()) {
+	if interval <= 0 {
+		panic("threadsafe: janitor interval must be positive")
+	}
+
+	j.done = make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				f()
+			case <-j.done:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the goroutine, if one was started. It is safe to call stop more than once.
+func (j *janitor) stop() {
+	j.once.Do(func() {
+		if j.done != nil {
+			close(j.done)
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package threadsafe
+
+import "sync"
+
+// KeyedMutex is a set of mutual exclusion locks, one per key. Locking one key never blocks goroutines working on a
+// different key. Locks are created on demand and released once nothing holds or waits on them, so memory use follows
+// the number of keys in use rather than the number ever seen. The zero value is ready to use.
+type KeyedMutex[K comparable] struct {
+	locks map[K]*keyedLock
+	lock  sync.Mutex
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// Lock locks key K. If the key is already locked, Lock blocks until it is available.
+func (km *KeyedMutex[K]) Lock(key K) {
+	km.lock.Lock()
+	if km.locks == nil {
+		km.locks = make(map[K]*keyedLock)
+	}
+
+	l, ok := km.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		km.locks[key] = l
+	}
+	l.refs++
+	km.lock.Unlock()
+
+	l.Lock()
+}
+
+// Unlock unlocks key K. It is a run-time error if the key is not locked.
+func (km *KeyedMutex[K]) Unlock(key K) {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+
+	l, ok := km.locks[key]
+	if !ok {
+		panic("threadsafe: unlock of unlocked KeyedMutex key")
+	}
+
+	l.refs--
+	if l.refs == 0 {
+		delete(km.locks, key)
+	}
+
+	l.Unlock()
+}
@@ -0,0 +1,202 @@
+package threadsafe
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+)
+
+// LFUMap represents a generic map holding at most a fixed number of entries. When a new key is set on a full map, the
+// least frequently used entry is evicted, with ties broken by evicting the least recently used. Both Get and Set count
+// as a use.
+type LFUMap[K comparable, V any] struct {
+	capacity int
+	items    map[K]*lfuEntry[K, V]
+	freqs    map[int]*list.List
+	minFreq  int
+	onEvict  func(K, V, EvictionReason)
+	lock     sync.Mutex
+}
+
+type lfuEntry[K comparable, V any] struct {
+	key   K
+	value V
+	freq  int
+	el    *list.Element
+}
+
+// NewLFUMap returns an LFUMap holding at most capacity entries. NewLFUMap panics if capacity is less than 1.
+func NewLFUMap[K comparable, V any](capacity int) *LFUMap[K, V] {
+	if capacity < 1 {
+		panic("threadsafe: LFUMap capacity must be at least 1")
+	}
+
+	return &LFUMap[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*lfuEntry[K, V], capacity),
+		freqs:    make(map[int]*list.List),
+	}
+}
+
+// Get returns the value V at key K and increments its use count. Also returns a boolean representing if the value was
+// found or not.
+func (m *LFUMap[K, V]) Get(key K) (V, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	m.touch(e)
+
+	return e.value, true
+}
+
+// Peek behaves like Get but does not increment the use count of the key.
+func (m *LFUMap[K, V]) Peek(key K) (V, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e, ok := m.items[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	return e.value, true
+}
+
+// Set writes the value V at key K and increments its use count. If K is new and the map is full, the least frequently
+// used entry is evicted first.
+func (m *LFUMap[K, V]) Set(key K, value V) {
+	m.lock.Lock()
+	evicted := m.set(key, value)
+	onEvict := m.onEvict
+	m.lock.Unlock()
+
+	if evicted != nil && onEvict != nil {
+		onEvict(evicted.key, evicted.value, EvictedCapacity)
+	}
+}
+
+// set writes the value V at key K, returning the entry that was evicted to make room for it, if any.
+func (m *LFUMap[K, V]) set(key K, value V) *lfuEntry[K, V] {
+	if e, ok := m.items[key]; ok {
+		e.value = value
+		m.touch(e)
+		return nil
+	}
+
+	var evicted *lfuEntry[K, V]
+	if len(m.items) >= m.capacity {
+		evicted = m.freqs[m.minFreq].Back().Value.(*lfuEntry[K, V])
+		m.removeEntry(evicted)
+	}
+
+	e := &lfuEntry[K, V]{key: key, value: value, freq: 1}
+	e.el = m.bucket(1).PushFront(e)
+	m.items[key] = e
+	m.minFreq = 1
+
+	return evicted
+}
+
+// Delete deletes the key K, if it exists.
+func (m *LFUMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if e, ok := m.items[key]; ok {
+		m.removeEntry(e)
+	}
+}
+
+// OnEvict registers f to be called whenever an entry is evicted to make room for a new one. f is called after the map
+// has been unlocked, so it may safely call methods on the map. Calling OnEvict again replaces the previous callback,
+// and a nil f removes it.
+func (m *LFUMap[K, V]) OnEvict(f func(key K, value V, reason EvictionReason)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.onEvict = f
+}
+
+// touch moves e into the bucket for its next use count.
+func (m *LFUMap[K, V]) touch(e *lfuEntry[K, V]) {
+	m.unlink(e)
+	if e.freq == m.minFreq && m.freqs[e.freq] == nil {
+		m.minFreq++
+	}
+
+	e.freq++
+	e.el = m.bucket(e.freq).PushFront(e)
+}
+
+func (m *LFUMap[K, V]) removeEntry(e *lfuEntry[K, V]) {
+	m.unlink(e)
+	delete(m.items, e.key)
+}
+
+// unlink removes e from its frequency bucket, dropping the bucket if it becomes empty.
+func (m *LFUMap[K, V]) unlink(e *lfuEntry[K, V]) {
+	l := m.freqs[e.freq]
+	l.Remove(e.el)
+	if l.Len() == 0 {
+		delete(m.freqs, e.freq)
+	}
+}
+
+func (m *LFUMap[K, V]) bucket(freq int) *list.List {
+	l, ok := m.freqs[freq]
+	if !ok {
+		l = list.New()
+		m.freqs[freq] = l
+	}
+
+	return l
+}
+
+// Keys returns a slice of K keys, ordered from most to least frequently used.
+func (m *LFUMap[K, V]) Keys() []K {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	freqs := make([]int, 0, len(m.freqs))
+	for f := range m.freqs {
+		freqs = append(freqs, f)
+	}
+	slices.Sort(freqs)
+
+	keys := make([]K, 0, len(m.items))
+	for i := len(freqs) - 1; i >= 0; i-- {
+		for el := m.freqs[freqs[i]].Front(); el != nil; el = el.Next() {
+			keys = append(keys, el.Value.(*lfuEntry[K, V]).key)
+		}
+	}
+
+	return keys
+}
+
+// Empty deletes all keys in the map.
+func (m *LFUMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.items = make(map[K]*lfuEntry[K, V], m.capacity)
+	m.freqs = make(map[int]*list.List)
+	m.minFreq = 0
+}
+
+// Len returns the length of the map.
+func (m *LFUMap[K, V]) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return len(m.items)
+}
+
+// Capacity returns the maximum number of entries the map can hold.
+func (m *LFUMap[K, V]) Capacity() int {
+	return m.capacity
+}
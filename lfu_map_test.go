@@ -0,0 +1,168 @@
+package threadsafe
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestLFUMapEvictionOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ops      []string // "s:k" sets k, "g:k" gets k, "d:k" deletes k
+		evicted  []string
+		keys     []string
+	}{
+		{
+			name:     "least frequent first",
+			capacity: 2,
+			ops:      []string{"s:a", "s:b", "g:a", "s:c"},
+			evicted:  []string{"b"},
+			keys:     []string{"a", "c"},
+		},
+		{
+			name:     "ties broken by least recent",
+			capacity: 3,
+			ops:      []string{"s:a", "s:b", "s:c", "s:d"},
+			evicted:  []string{"a"},
+			keys:     []string{"d", "c", "b"},
+		},
+		{
+			name:     "set counts as a use",
+			capacity: 2,
+			ops:      []string{"s:a", "s:b", "s:a", "s:c"},
+			evicted:  []string{"b"},
+			keys:     []string{"a", "c"},
+		},
+		{
+			name:     "new keys are evicted before frequent ones",
+			capacity: 2,
+			ops:      []string{"s:a", "g:a", "g:a", "s:b", "s:c", "s:d"},
+			evicted:  []string{"b", "c"},
+			keys:     []string{"a", "d"},
+		},
+		{
+			name:     "delete frees a slot",
+			capacity: 2,
+			ops:      []string{"s:a", "s:b", "d:a", "s:c"},
+			evicted:  nil,
+			keys:     []string{"c", "b"},
+		},
+		{
+			name:     "min frequency resets after delete",
+			capacity: 2,
+			ops:      []string{"s:a", "g:a", "s:b", "g:b", "d:b", "s:c", "g:c", "s:d"},
+			evicted:  []string{"a"},
+			keys:     []string{"c", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewLFUMap[string, int](tt.capacity)
+
+			var evicted []string
+			m.OnEvict(func(key string, _ int, reason EvictionReason) {
+				if reason != EvictedCapacity {
+					t.Errorf("reason = %v, want EvictedCapacity", reason)
+				}
+				evicted = append(evicted, key)
+			})
+
+			for _, op := range tt.ops {
+				switch k := op[2:]; op[0] {
+				case 's':
+					m.Set(k, 0)
+				case 'g':
+					m.Get(k)
+				case 'd':
+					m.Delete(k)
+				}
+			}
+
+			if !slices.Equal(evicted, tt.evicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.evicted)
+			}
+			if keys := m.Keys(); !slices.Equal(keys, tt.keys) {
+				t.Errorf("Keys() = %v, want %v", keys, tt.keys)
+			}
+		})
+	}
+}
+
+// lfuModel is a slow reference LFU: it evicts the key with the lowest use count, breaking ties by the oldest last use.
+type lfuModel struct {
+	capacity int
+	freq     map[int]int
+	used     map[int]int
+	clock    int
+}
+
+func (m *lfuModel) use(k int) {
+	m.clock++
+	m.freq[k]++
+	m.used[k] = m.clock
+}
+
+func (m *lfuModel) set(k int) (evicted int, ok bool) {
+	if _, exists := m.freq[k]; !exists && len(m.freq) >= m.capacity {
+		first := true
+		for c := range m.freq {
+			if first || m.freq[c] < m.freq[evicted] || m.freq[c] == m.freq[evicted] && m.used[c] < m.used[evicted] {
+				evicted, first = c, false
+			}
+		}
+		delete(m.freq, evicted)
+		delete(m.used, evicted)
+		ok = true
+	}
+	m.use(k)
+
+	return evicted, ok
+}
+
+func TestLFUMapMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	for range 50 {
+		capacity := 1 + r.IntN(8)
+		m := NewLFUMap[int, int](capacity)
+		model := &lfuModel{capacity: capacity, freq: map[int]int{}, used: map[int]int{}}
+
+		var evicted []int
+		m.OnEvict(func(key int, _ int, _ EvictionReason) {
+			evicted = append(evicted, key)
+		})
+
+		for range 500 {
+			k := r.IntN(2 * capacity)
+			switch r.IntN(4) {
+			case 0, 1:
+				evicted = evicted[:0]
+				m.Set(k, k)
+				want, ok := model.set(k)
+				if ok != (len(evicted) == 1) || ok && evicted[0] != want {
+					t.Fatalf("Set(%d) evicted %v, want %d (%v)", k, evicted, want, ok)
+				}
+			case 2:
+				_, got := m.Get(k)
+				_, want := model.freq[k]
+				if got != want {
+					t.Fatalf("Get(%d) found = %v, want %v", k, got, want)
+				}
+				if want {
+					model.use(k)
+				}
+			case 3:
+				m.Delete(k)
+				delete(model.freq, k)
+				delete(model.used, k)
+			}
+
+			if m.Len() != len(model.freq) {
+				t.Fatalf("Len() = %d, want %d", m.Len(), len(model.freq))
+			}
+		}
+	}
+}
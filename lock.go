@@ -0,0 +1,28 @@
+package threadsafe
+
+import (
+	"reflect"
+	"sync"
+)
+
+// lockPair locks both a and b in a consistent order, based on their addresses, so that two goroutines locking the same
+// pair of values in opposite argument order cannot deadlock. If a and b are the same lock it is only locked once. The
+// returned function unlocks both.
+func lockPair(a, b sync.Locker) (unlock func()) {
+	if a == b {
+		a.Lock()
+		return a.Unlock
+	}
+
+	if reflect.ValueOf(a).Pointer() > reflect.ValueOf(b).Pointer() {
+		a, b = b, a
+	}
+
+	a.Lock()
+	b.Lock()
+
+	return func() {
+		b.Unlock()
+		a.Unlock()
+	}
+}
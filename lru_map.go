@@ -0,0 +1,156 @@
+package threadsafe
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRUMap represents a generic map holding at most a fixed number of entries. When a new key is set on a full map, the
+// least recently used entry is evicted. Both Get and Set count as a use.
+type LRUMap[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.Element
+	order    *list.List
+	onEvict  func(K, V, EvictionReason)
+	lock     sync.Mutex
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewLRUMap returns an LRUMap holding at most capacity entries. NewLRUMap panics if capacity is less than 1.
+func NewLRUMap[K comparable, V any](capacity int) *LRUMap[K, V] {
+	if capacity < 1 {
+		panic("threadsafe: LRUMap capacity must be at least 1")
+	}
+
+	return &LRUMap[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+// Get returns the value V at key K and marks it as the most recently used. Also returns a boolean representing if the
+// value was found or not.
+func (m *LRUMap[K, V]) Get(key K) (V, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	m.order.MoveToFront(el)
+
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Peek behaves like Get but does not update the recency of the key.
+func (m *LRUMap[K, V]) Peek(key K) (V, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Set writes the value V at key K and marks it as the most recently used. If K is new and the map is full, the least
+// recently used entry is evicted first.
+func (m *LRUMap[K, V]) Set(key K, value V) {
+	m.lock.Lock()
+	evicted := m.set(key, value)
+	onEvict := m.onEvict
+	m.lock.Unlock()
+
+	if evicted != nil && onEvict != nil {
+		onEvict(evicted.key, evicted.value, EvictedCapacity)
+	}
+}
+
+// set writes the value V at key K, returning the entry that was evicted to make room for it, if any.
+func (m *LRUMap[K, V]) set(key K, value V) *entry[K, V] {
+	if el, ok := m.items[key]; ok {
+		el.Value.(*entry[K, V]).value = value
+		m.order.MoveToFront(el)
+		return nil
+	}
+
+	var evicted *entry[K, V]
+	if m.order.Len() >= m.capacity {
+		back := m.order.Back()
+		evicted = back.Value.(*entry[K, V])
+		m.removeElement(back)
+	}
+
+	m.items[key] = m.order.PushFront(&entry[K, V]{key: key, value: value})
+
+	return evicted
+}
+
+// Delete deletes the key K, if it exists.
+func (m *LRUMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if el, ok := m.items[key]; ok {
+		m.removeElement(el)
+	}
+}
+
+func (m *LRUMap[K, V]) removeElement(el *list.Element) {
+	m.order.Remove(el)
+	delete(m.items, el.Value.(*entry[K, V]).key)
+}
+
+// OnEvict registers f to be called whenever an entry is evicted to make room for a new one. f is called after the map
+// has been unlocked, so it may safely call methods on the map. Calling OnEvict again replaces the previous callback,
+// and a nil f removes it.
+func (m *LRUMap[K, V]) OnEvict(f func(key K, value V, reason EvictionReason)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.onEvict = f
+}
+
+// Keys returns a slice of K keys, ordered from most to least recently used.
+func (m *LRUMap[K, V]) Keys() []K {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	keys := make([]K, 0, m.order.Len())
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		keys = append(keys, el.Value.(*entry[K, V]).key)
+	}
+
+	return keys
+}
+
+// Empty deletes all keys in the map.
+func (m *LRUMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.items = make(map[K]*list.Element, m.capacity)
+	m.order.Init()
+}
+
+// Len returns the length of the map.
+func (m *LRUMap[K, V]) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.order.Len()
+}
+
+// Capacity returns the maximum number of entries the map can hold.
+func (m *LRUMap[K, V]) Capacity() int {
+	return m.capacity
+}
@@ -1,12 +1,33 @@
 package threadsafe
 
-import "sync"
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
 
-// Map represents a generic map[comparable]any that locks itself on each operation. The underlying map Data is left
-// exposed to not block any potential operations that might be needed, but should generally not be touched directly.
+// maxStringEntries is the maximum number of entries rendered by Map.String.
+const maxStringEntries = 100
+
+// Map represents a generic map[comparable]any that locks itself on each operation. Read-only operations take a shared
+// read lock, so they can run concurrently with each other. The underlying map Data is left exposed to not block any
+// potential operations that might be needed, but should generally not be touched directly.
+//
+// The zero value is an empty map ready to use; Data is allocated on the first write.
 type Map[K comparable, V any] struct {
-	Data map[K]V
-	lock sync.Mutex
+	Data     map[K]V
+	lock     sync.RWMutex
+	keyLocks KeyedMutex[K]
+	watchers *mapWatchers[K, V]
+	stats    atomic.Pointer[mapStats]
+	hooks    mapHooks[K, V]
+	pending  []func()
+	deepCopy atomic.Bool
 }
 
 func NewMap[K comparable, V any]() *Map[K, V] {
@@ -15,54 +36,466 @@ func NewMap[K comparable, V any]() *Map[K, V] {
 	}
 }
 
+// NewMapWithCapacity returns a Map whose underlying map is preallocated to hold n entries.
+func NewMapWithCapacity[K comparable, V any](n int) *Map[K, V] {
+	return &Map[K, V]{
+		Data: make(map[K]V, n),
+	}
+}
+
+// NewMapFrom returns a Map containing a copy of data. Later changes to data are not reflected in the Map.
+func NewMapFrom[K comparable, V any](data map[K]V) *Map[K, V] {
+	m := NewMapWithCapacity[K, V](len(data))
+	for k, v := range data {
+		m.Data[k] = v
+	}
+
+	return m
+}
+
 // Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
 func (m *Map[K, V]) Get(key K) (V, bool) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockRead()
+	defer m.lock.RUnlock()
 
 	v, ok := m.Data[key]
+	m.recordGet(ok)
 
-	return v, ok
+	return m.copyValue(v), ok
 }
 
 // Pull behaves like Get but will also delete the key from the map before returning and unlocking the map. This can be
 // useful for singleton operations.
 func (m *Map[K, V]) Pull(key K) (V, bool) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockWrite()
+	defer m.unlockWrite()
 
 	v, ok := m.Data[key]
 	if !ok {
 		return v, ok
 	}
 
-	m.Delete(key)
+	m.delete(key)
+
+	return m.copyValue(v), ok
+}
+
+// Pop removes and returns an arbitrary entry from the map. The boolean is false if the map was empty.
+func (m *Map[K, V]) Pop() (K, V, bool) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	for k, v := range m.Data {
+		m.delete(k)
+		return k, m.copyValue(v), true
+	}
 
-	return v, ok
+	return *new(K), *new(V), false
+}
+
+// GetOrSet returns the existing value V at key K if present. Otherwise, it sets and returns the given value. The loaded
+// result is true if the value was loaded, false if stored.
+func (m *Map[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	if v, ok := m.Data[key]; ok {
+		return m.copyValue(v), true
+	}
+
+	m.set(key, value)
+
+	return value, false
+}
+
+// SetIfAbsent sets the value V at key K only if the key is not already present. It returns the value now stored at K
+// and whether this call was the one that set it.
+func (m *Map[K, V]) SetIfAbsent(key K, value V) (actual V, set bool) {
+	actual, loaded := m.GetOrSet(key, value)
+
+	return actual, !loaded
 }
 
 // Set writes the value V at key K.
 func (m *Map[K, V]) Set(key K, value V) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockWrite()
+	defer m.unlockWrite()
 
-	m.Data[key] = value
+	m.set(key, value)
+}
+
+// Compute atomically updates the value at key K. The function f is called under the lock with the current value and
+// whether it exists. If f returns true the returned value is stored, otherwise the key is deleted. Compute returns the
+// value f produced and whether it was stored.
+//
+// f must not call other methods on the map, or it will deadlock.
+func (m *Map[K, V]) Compute(key K, f func(old V, exists bool) (V, bool)) (V, bool) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	old, exists := m.Data[key]
+
+	v, keep := f(old, exists)
+	if keep {
+		m.set(key, v)
+	} else if exists {
+		m.delete(key)
+	}
+
+	return m.copyValue(v), keep
+}
+
+// DoKey behaves like Compute, but only locks key K for the duration of f rather than the whole map. Concurrent DoKey
+// calls on the same key run one at a time, while other keys, and other methods, remain available. This suits
+// long-running per-key work. Unlike Compute, f may call other methods on the map, but writes to K made outside of DoKey
+// can interleave with it.
+func (m *Map[K, V]) DoKey(key K, f func(old V, exists bool) (V, bool)) (V, bool) {
+	m.keyLocks.Lock(key)
+	defer m.keyLocks.Unlock(key)
+
+	old, exists := m.Get(key)
+
+	v, keep := f(old, exists)
+	if !keep {
+		if exists {
+			m.Delete(key)
+		}
+		return v, keep
+	}
+
+	// Copy while still holding the lock, as v is now the stored value and may be changed in place under it.
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.set(key, v)
+
+	return m.copyValue(v), keep
 }
 
 // Delete deletes the key K, if it exists.
 func (m *Map[K, V]) Delete(key K) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.delete(key)
+}
+
+// Swap writes the value V at key K and returns the previous value, along with whether the key existed.
+func (m *Map[K, V]) Swap(key K, value V) (old V, existed bool) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	old, existed = m.Data[key]
+	m.set(key, value)
 
-	if _, ok := m.Data[key]; ok {
-		delete(m.Data, key)
+	return m.copyValue(old), existed
+}
+
+// CompareAndSwapFunc swaps the value at key K for new if the key exists and eq reports its current value as equal to
+// old. Returns true if the swap was performed.
+func (m *Map[K, V]) CompareAndSwapFunc(key K, old, new V, eq func(a, b V) bool) bool {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	v, ok := m.Data[key]
+	if !ok || !eq(v, old) {
+		return false
+	}
+
+	m.set(key, new)
+
+	return true
+}
+
+// CompareAndDeleteFunc deletes the key K if it exists and eq reports its current value as equal to old. Returns true if
+// the key was deleted.
+func (m *Map[K, V]) CompareAndDeleteFunc(key K, old V, eq func(a, b V) bool) bool {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	v, ok := m.Data[key]
+	if !ok || !eq(v, old) {
+		return false
+	}
+
+	m.delete(key)
+
+	return true
+}
+
+// CompareAndSwap is CompareAndSwapFunc for maps with comparable values, using == for equality.
+func CompareAndSwap[K, V comparable](m *Map[K, V], key K, old, new V) bool {
+	return m.CompareAndSwapFunc(key, old, new, equal[V])
+}
+
+// CompareAndDelete is CompareAndDeleteFunc for maps with comparable values, using == for equality.
+func CompareAndDelete[K, V comparable](m *Map[K, V], key K, old V) bool {
+	return m.CompareAndDeleteFunc(key, old, equal[V])
+}
+
+// Invert returns a new Map with the keys and values of m swapped, built under a single lock. When several keys share
+// the same value, resolve is called with the value and the two competing keys and its result is kept. If resolve is
+// nil, which key wins is unspecified.
+func Invert[K, V comparable](m *Map[K, V], resolve func(value V, existing, incoming K) K) *Map[V, K] {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	inverted := NewMapWithCapacity[V, K](len(m.Data))
+	for k, v := range m.Data {
+		if existing, ok := inverted.Data[v]; ok && resolve != nil {
+			inverted.Data[v] = resolve(v, existing, k)
+		} else {
+			inverted.Data[v] = k
+		}
+	}
+
+	return inverted
+}
+
+// Increment adds delta to the value at key K and returns the result. Missing keys start at zero.
+func Increment[K comparable, V Number](m *Map[K, V], key K, delta V) V {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	v := m.Data[key] + delta
+	m.set(key, v)
+
+	return v
+}
+
+// AppendValue appends elems to the slice at key K and returns the resulting slice. Missing keys start as an empty
+// slice. The whole read-append-write happens under the lock, so concurrent appends are never lost.
+func AppendValue[K comparable, E any](m *Map[K, []E], key K, elems ...E) []E {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	v := append(m.Data[key], elems...)
+	m.set(key, v)
+
+	return v
+}
+
+// GroupMapBy buckets the values of m by the group f returns for each entry, built under a single lock. Go methods can't
+// declare type parameters, so this is a function rather than a Map method. The order of values within each group is
+// unspecified.
+func GroupMapBy[K comparable, V any, G comparable](m *Map[K, V], f func(key K, value V) G) *Map[G, *Slice[V]] {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	groups := NewMap[G, *Slice[V]]()
+	for k, v := range m.Data {
+		g := f(k, v)
+
+		s, ok := groups.Data[g]
+		if !ok {
+			s = &Slice[V]{}
+			groups.Data[g] = s
+		}
+		s.Data = append(s.Data, m.copyValue(v))
+	}
+
+	return groups
+}
+
+// SortedKeys returns the keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m *Map[K, V]) []K {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	return sortedKeys(m.Data)
+}
+
+// SortedItems returns the keys of m in ascending order along with their matching values, taken under a single lock.
+func SortedItems[K cmp.Ordered, V any](m *Map[K, V]) ([]K, []V) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	keys := sortedKeys(m.Data)
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = m.copyValue(m.Data[k])
+	}
+
+	return keys, values
+}
+
+func sortedKeys[K cmp.Ordered, V any](data map[K]V) []K {
+	keys := make([]K, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// keysByName returns the keys of data sorted by their fmt.Sprint representation, which gives a deterministic order for
+// any key type.
+func keysByName[K comparable, V any](data map[K]V) []K {
+	type namedKey struct {
+		name string
+		key  K
+	}
+
+	named := make([]namedKey, 0, len(data))
+	for k := range data {
+		named = append(named, namedKey{name: fmt.Sprint(k), key: k})
+	}
+	slices.SortFunc(named, func(a, b namedKey) int {
+		return cmp.Compare(a.name, b.name)
+	})
+
+	keys := make([]K, len(named))
+	for i, n := range named {
+		keys[i] = n.key
+	}
+
+	return keys
+}
+
+// keysInPrintOrder returns the keys of data in the order fmt prints a map: numerically for integer and float keys,
+// lexically for strings and false before true for bools. Keys of other kinds, and interface keys of differing kinds,
+// are grouped by kind and otherwise keep keysByName order.
+func keysInPrintOrder[K comparable, V any](data map[K]V) []K {
+	keys := keysByName(data)
+	slices.SortStableFunc(keys, func(a, b K) int {
+		av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+		if c := cmp.Compare(av.Kind(), bv.Kind()); c != 0 {
+			return c
+		}
+
+		switch av.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(av.Int(), bv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(av.Uint(), bv.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(av.Float(), bv.Float())
+		case reflect.String:
+			return cmp.Compare(av.String(), bv.String())
+		case reflect.Bool:
+			return cmp.Compare(boolRank(av.Bool()), boolRank(bv.Bool()))
+		default:
+			return 0
+		}
+	})
+
+	return keys
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func equal[T comparable](a, b T) bool {
+	return a == b
+}
+
+// GetMany returns the values for each of the given keys that exist in the map. Missing keys are omitted from the result.
+func (m *Map[K, V]) GetMany(keys ...K) map[K]V {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	values := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m.Data[k]; ok {
+			values[k] = m.copyValue(v)
+		}
+	}
+
+	return values
+}
+
+// SetMany writes every key-value pair in items to the map under a single lock.
+func (m *Map[K, V]) SetMany(items map[K]V) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	for k, v := range items {
+		m.set(k, v)
+	}
+}
+
+// DeleteMany deletes each of the given keys that exist under a single lock.
+func (m *Map[K, V]) DeleteMany(keys ...K) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	for _, k := range keys {
+		m.delete(k)
+	}
+}
+
+// Merge writes every key-value pair in other to the map under a single lock. When a key already exists, resolve is
+// called with the existing and incoming values and its result is stored. If resolve is nil, incoming values win.
+func (m *Map[K, V]) Merge(other map[K]V, resolve func(key K, existing, incoming V) V) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	for k, incoming := range other {
+		if existing, ok := m.Data[k]; ok && resolve != nil {
+			m.set(k, resolve(k, existing, incoming))
+		} else {
+			m.set(k, incoming)
+		}
+	}
+}
+
+// MergeMap behaves like Merge but takes another Map. The other map is copied under its own lock first, so the two
+// locks are never held at the same time.
+func (m *Map[K, V]) MergeMap(other *Map[K, V], resolve func(key K, existing, incoming V) V) {
+	m.Merge(other.Snapshot(), resolve)
+}
+
+// DeleteFunc deletes every entry for which f returns true under a single lock. Returns the number of entries deleted.
+func (m *Map[K, V]) DeleteFunc(f func(key K, value V) bool) int {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	deleted := 0
+	for k, v := range m.Data {
+		if f(k, v) {
+			m.delete(k)
+			deleted++
+		}
+	}
+
+	return deleted
+}
+
+// Filter returns a new Map containing every entry for which f returns true. The map itself is left unchanged.
+func (m *Map[K, V]) Filter(f func(key K, value V) bool) *Map[K, V] {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	filtered := NewMap[K, V]()
+	for k, v := range m.Data {
+		if f(k, v) {
+			filtered.Data[k] = m.copyValue(v)
+		}
+	}
+
+	return filtered
+}
+
+// TransformValues replaces every value in the map with the result of f under a single lock.
+func (m *Map[K, V]) TransformValues(f func(key K, value V) V) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	for k, v := range m.Data {
+		m.set(k, f(k, v))
 	}
 }
 
 // Keys returns a slice of K keys.
 func (m *Map[K, V]) Keys() []K {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockRead()
+	defer m.lock.RUnlock()
 
 	keys := make([]K, len(m.Data))
 
@@ -77,14 +510,14 @@ func (m *Map[K, V]) Keys() []K {
 
 // Values returns a slice V values.
 func (m *Map[K, V]) Values() []V {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockRead()
+	defer m.lock.RUnlock()
 
 	values := make([]V, len(m.Data))
 
 	index := 0
 	for _, v := range m.Data {
-		values[index] = v
+		values[index] = m.copyValue(v)
 		index++
 	}
 
@@ -93,8 +526,8 @@ func (m *Map[K, V]) Values() []V {
 
 // Items returns both the slice of keys and values.
 func (m *Map[K, V]) Items() ([]K, []V) {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockRead()
+	defer m.lock.RUnlock()
 
 	keys := make([]K, len(m.Data))
 	values := make([]V, len(m.Data))
@@ -102,26 +535,215 @@ func (m *Map[K, V]) Items() ([]K, []V) {
 	index := 0
 	for k, v := range m.Data {
 		keys[index] = k
-		values[index] = v
+		values[index] = m.copyValue(v)
 		index++
 	}
 
 	return keys, values
 }
 
+// Range calls f sequentially for each key and value in the map. If f returns false, Range stops the iteration. The map
+// is locked for the duration of the call, so f must not call other methods on the map.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	for k, v := range m.Data {
+		if !f(k, m.copyValue(v)) {
+			return
+		}
+	}
+}
+
+// Find returns the first entry found for which f returns true. The boolean is false if no entry matched. Iteration
+// order is unspecified, so if several entries match, any one of them may be returned.
+func (m *Map[K, V]) Find(f func(key K, value V) bool) (K, V, bool) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	for k, v := range m.Data {
+		if f(k, v) {
+			return k, m.copyValue(v), true
+		}
+	}
+
+	return *new(K), *new(V), false
+}
+
+// Any reports whether f returns true for at least one entry in the map.
+func (m *Map[K, V]) Any(f func(key K, value V) bool) bool {
+	_, _, ok := m.Find(f)
+
+	return ok
+}
+
+// Every reports whether f returns true for every entry in the map. It returns true for an empty map.
+func (m *Map[K, V]) Every(f func(key K, value V) bool) bool {
+	_, _, ok := m.Find(func(k K, v V) bool {
+		return !f(k, v)
+	})
+
+	return !ok
+}
+
+// All returns an iterator over the key-value pairs in the map. The map is locked while the iterator runs, so the body
+// of the range loop must not call other methods on the map.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Range(yield)
+	}
+}
+
+// KeysSeq returns an iterator over the keys in the map. It holds the lock in the same way as All.
+func (m *Map[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		m.Range(func(k K, _ V) bool {
+			return yield(k)
+		})
+	}
+}
+
+// ValuesSeq returns an iterator over the values in the map. It holds the lock in the same way as All.
+func (m *Map[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		m.Range(func(_ K, v V) bool {
+			return yield(v)
+		})
+	}
+}
+
+// Snapshot returns a copy of the underlying map taken under a single lock.
+func (m *Map[K, V]) Snapshot() map[K]V {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	data := make(map[K]V, len(m.Data))
+	for k, v := range m.Data {
+		data[k] = m.copyValue(v)
+	}
+
+	return data
+}
+
+// Clone returns a new Map containing a copy of all entries, taken under a single lock.
+func (m *Map[K, V]) Clone() *Map[K, V] {
+	c := &Map[K, V]{
+		Data: m.Snapshot(),
+	}
+	c.deepCopy.Store(m.deepCopy.Load())
+
+	return c
+}
+
+// Equal reports whether the map and other contain the same keys, with eq reporting each pair of values as equal. Both
+// maps are locked for the duration of the comparison.
+func (m *Map[K, V]) Equal(other *Map[K, V], eq func(a, b V) bool) bool {
+	unlock := lockPair(m.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	if len(m.Data) != len(other.Data) {
+		return false
+	}
+
+	for k, a := range m.Data {
+		b, ok := other.Data[k]
+		if !ok || !eq(a, b) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MapsEqual is Map.Equal for maps with comparable values, using == for equality.
+func MapsEqual[K, V comparable](a, b *Map[K, V]) bool {
+	return a.Equal(b, equal[V])
+}
+
+// String implements fmt.Stringer. The map is rendered like a builtin map with sorted keys. Maps with more than
+// maxStringEntries entries are truncated to the first entries in that order, followed by a count of the rest.
+func (m *Map[K, V]) String() string {
+	data := m.Snapshot()
+	if len(data) <= maxStringEntries {
+		return fmt.Sprint(data)
+	}
+
+	var b strings.Builder
+	b.WriteString("map[")
+	for i, k := range keysInPrintOrder(data)[:maxStringEntries] {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%v:%v", k, data[k])
+	}
+	fmt.Fprintf(&b, " ...+%d more]", len(data)-maxStringEntries)
+
+	return b.String()
+}
+
 // Empty deletes all keys in the map.
 func (m *Map[K, V]) Empty() {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockWrite()
+	defer m.unlockWrite()
 
-	m.Data = nil
-	m.Data = make(map[K]V)
+	m.clear()
+}
+
+// Drain returns the underlying map and replaces it with an empty one under a single lock.
+func (m *Map[K, V]) Drain() map[K]V {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	return m.clear()
 }
 
 // Len returns the length of the map.
 func (m *Map[K, V]) Len() int {
-	m.lock.Lock()
-	defer m.lock.Unlock()
+	m.lockRead()
+	defer m.lock.RUnlock()
 
 	return len(m.Data)
 }
+
+// set writes the value V at key K and notifies any watchers. The caller must hold the write lock.
+func (m *Map[K, V]) set(key K, value V) {
+	if m.Data == nil {
+		m.Data = make(map[K]V)
+	}
+
+	m.Data[key] = value
+	if st := m.stats.Load(); st != nil {
+		st.sets.Add(1)
+	}
+	m.notify(MapEvent[K, V]{Key: key, Value: value})
+	m.queueSet(key, value)
+}
+
+// delete deletes the key K, if it exists, and notifies any watchers. The caller must hold the write lock.
+func (m *Map[K, V]) delete(key K) {
+	v, ok := m.Data[key]
+	if !ok {
+		return
+	}
+
+	delete(m.Data, key)
+	if st := m.stats.Load(); st != nil {
+		st.deletes.Add(1)
+	}
+	m.notify(MapEvent[K, V]{Key: key, Value: v, Deleted: true})
+	m.queueDelete(key, v)
+}
+
+// clear replaces the underlying map with an empty one and notifies any watchers, returning the previous map. The
+// caller must hold the write lock.
+func (m *Map[K, V]) clear() map[K]V {
+	data := m.Data
+	m.Data = make(map[K]V)
+	if st := m.stats.Load(); st != nil {
+		st.deletes.Add(uint64(len(data)))
+	}
+	m.notifyAllDeleted(data)
+	m.queueEmpty(data)
+
+	return data
+}
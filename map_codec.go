@@ -0,0 +1,178 @@
+package threadsafe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"reflect"
+)
+
+var errInvalidBinary = errors.New("threadsafe: invalid Map binary encoding")
+
+// GobEncode implements gob.GobEncoder. The underlying map is encoded under the read lock.
+func (m *Map[K, V]) GobEncode() ([]byte, error) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(m.Data); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder. The map's contents are replaced by the decoded entries. If decoding fails the
+// map is left unchanged.
+func (m *Map[K, V]) GobDecode(b []byte) error {
+	var data map[K]V
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&data); err != nil {
+		return err
+	}
+
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.replace(data)
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with a compact encoding: the number of entries as a uvarint,
+// followed by each key and value. Integers are written as varints, strings and byte slices as a uvarint length
+// followed by their bytes, and any other fixed-size type, as accepted by encoding/binary, in little-endian byte order.
+// Types outside those, such as maps, pointers or structs holding strings, are rejected; use gob for them.
+func (m *Map[K, V]) MarshalBinary() ([]byte, error) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	buf := binary.AppendUvarint(nil, uint64(len(m.Data)))
+	for k, v := range m.Data {
+		var err error
+		if buf, err = appendBinary(buf, k); err != nil {
+			return nil, err
+		}
+		if buf, err = appendBinary(buf, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding the format written by MarshalBinary. The map's
+// contents are replaced by the decoded entries. If decoding fails the map is left unchanged.
+func (m *Map[K, V]) UnmarshalBinary(b []byte) error {
+	n, read := binary.Uvarint(b)
+	if read <= 0 {
+		return errors.New("threadsafe: invalid Map binary length")
+	}
+	b = b[read:]
+
+	data := make(map[K]V, min(n, uint64(len(b))))
+	for i := uint64(0); i < n; i++ {
+		var k K
+		read, err := decodeBinary(b, &k)
+		if err != nil {
+			return err
+		}
+		b = b[read:]
+
+		var v V
+		if read, err = decodeBinary(b, &v); err != nil {
+			return err
+		}
+		b = b[read:]
+
+		data[k] = v
+	}
+
+	if len(b) != 0 {
+		return errors.New("threadsafe: trailing data after Map binary encoding")
+	}
+
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.replace(data)
+
+	return nil
+}
+
+// appendBinary appends the encoding of v used by MarshalBinary to buf.
+func appendBinary(buf []byte, v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(buf, rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(buf, rv.Uint()), nil
+	case reflect.String:
+		buf = binary.AppendUvarint(buf, uint64(rv.Len()))
+		return append(buf, rv.String()...), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			buf = binary.AppendUvarint(buf, uint64(rv.Len()))
+			return append(buf, rv.Bytes()...), nil
+		}
+	}
+
+	return binary.Append(buf, binary.LittleEndian, v)
+}
+
+// decodeBinary decodes a value written by appendBinary from b into the value p points to. Returns the number of bytes
+// read.
+func decodeBinary(b []byte, p any) (int, error) {
+	rv := reflect.ValueOf(p).Elem()
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, read := binary.Varint(b)
+		if read <= 0 || rv.OverflowInt(x) {
+			return 0, errInvalidBinary
+		}
+		rv.SetInt(x)
+
+		return read, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, read := binary.Uvarint(b)
+		if read <= 0 || rv.OverflowUint(x) {
+			return 0, errInvalidBinary
+		}
+		rv.SetUint(x)
+
+		return read, nil
+	case reflect.String:
+		data, read, err := decodeBinaryBytes(b)
+		if err != nil {
+			return 0, err
+		}
+		rv.SetString(string(data))
+
+		return read, nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			data, read, err := decodeBinaryBytes(b)
+			if err != nil {
+				return 0, err
+			}
+			rv.SetBytes(bytes.Clone(data))
+
+			return read, nil
+		}
+	}
+
+	return binary.Decode(b, binary.LittleEndian, p)
+}
+
+// decodeBinaryBytes decodes a uvarint length followed by that many bytes from b. The returned bytes alias b.
+func decodeBinaryBytes(b []byte) ([]byte, int, error) {
+	n, read := binary.Uvarint(b)
+	if read <= 0 || n > uint64(len(b)-read) {
+		return nil, 0, errInvalidBinary
+	}
+
+	return b[read : read+int(n)], read + int(n), nil
+}
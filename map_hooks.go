@@ -0,0 +1,76 @@
+package threadsafe
+
+import "maps"
+
+type mapHooks[K comparable, V any] struct {
+	onSet    []func(K, V)
+	onDelete []func(K, V)
+	onEmpty  []func(map[K]V)
+}
+
+// OnSet registers f to be called whenever a value is written to the map, by any method. Hooks run after the map has
+// been unlocked, in the order they were registered, on the goroutine that made the change, so they may safely call
+// methods on the map.
+func (m *Map[K, V]) OnSet(f func(key K, value V)) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.hooks.onSet = append(m.hooks.onSet, f)
+}
+
+// OnDelete registers f to be called whenever a single key is removed from the map. Removing every entry at once fires
+// OnEmpty hooks instead. It runs in the same way as OnSet hooks.
+func (m *Map[K, V]) OnDelete(f func(key K, value V)) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.hooks.onDelete = append(m.hooks.onDelete, f)
+}
+
+// OnEmpty registers f to be called with a copy of the removed entries whenever the map is emptied as a whole, by
+// Empty, Drain, or replacing its contents with UnmarshalJSON, GobDecode, UnmarshalBinary or LoadFrom. It runs in the
+// same way as OnSet hooks.
+func (m *Map[K, V]) OnEmpty(f func(removed map[K]V)) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.hooks.onEmpty = append(m.hooks.onEmpty, f)
+}
+
+// unlockWrite releases the write lock and then runs any hooks queued while it was held.
+func (m *Map[K, V]) unlockWrite() {
+	pending := m.pending
+	m.pending = nil
+	m.lock.Unlock()
+
+	for _, f := range pending {
+		f()
+	}
+}
+
+// queueSet queues the OnSet hooks for a write. The caller must hold the write lock.
+func (m *Map[K, V]) queueSet(key K, value V) {
+	for _, h := range m.hooks.onSet {
+		v := m.copyValue(value)
+		m.pending = append(m.pending, func() { h(key, v) })
+	}
+}
+
+// queueDelete queues the OnDelete hooks for a removal. The caller must hold the write lock.
+func (m *Map[K, V]) queueDelete(key K, value V) {
+	for _, h := range m.hooks.onDelete {
+		m.pending = append(m.pending, func() { h(key, value) })
+	}
+}
+
+// queueEmpty queues the OnEmpty hooks for the removal of data. The caller must hold the write lock.
+func (m *Map[K, V]) queueEmpty(data map[K]V) {
+	if len(m.hooks.onEmpty) == 0 {
+		return
+	}
+
+	removed := maps.Clone(data)
+	for _, h := range m.hooks.onEmpty {
+		m.pending = append(m.pending, func() { h(maps.Clone(removed)) })
+	}
+}
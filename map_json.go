@@ -0,0 +1,37 @@
+package threadsafe
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. The map is encoded as a plain JSON object under the read lock.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	return json.Marshal(m.Data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The map's contents are replaced by the decoded JSON object, so a zero
+// Map can be decoded into directly. If decoding fails the map is left unchanged.
+func (m *Map[K, V]) UnmarshalJSON(b []byte) error {
+	var data map[K]V
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.replace(data)
+
+	return nil
+}
+
+// replace swaps the map's contents for data, notifying watchers of every removed and written entry. The caller must
+// hold the write lock.
+func (m *Map[K, V]) replace(data map[K]V) {
+	m.clear()
+
+	for k, v := range data {
+		m.set(k, v)
+	}
+}
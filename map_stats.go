@@ -0,0 +1,89 @@
+package threadsafe
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// MapStats holds operation counts for a Map with stats enabled.
+type MapStats struct {
+	// Hits and Misses count calls to Get that did and did not find their key.
+	Hits, Misses uint64
+	// Sets and Deletes count individual entries written and removed by any method.
+	Sets, Deletes uint64
+	// LockWait is the total time spent waiting to acquire the map's lock.
+	LockWait time.Duration
+}
+
+type mapStats struct {
+	hits, misses  atomic.Uint64
+	sets, deletes atomic.Uint64
+	lockWait      atomic.Int64
+}
+
+// EnableStats starts recording operation counts and lock wait time, retrievable with Stats. Stats are disabled by
+// default to keep the common path free of the extra bookkeeping. Calling EnableStats again resets the counts.
+func (m *Map[K, V]) EnableStats() {
+	m.stats.Store(&mapStats{})
+}
+
+// DisableStats stops recording stats.
+func (m *Map[K, V]) DisableStats() {
+	m.stats.Store(nil)
+}
+
+// Stats returns the counts recorded since EnableStats was called. It returns a zero MapStats if stats are disabled.
+func (m *Map[K, V]) Stats() MapStats {
+	st := m.stats.Load()
+	if st == nil {
+		return MapStats{}
+	}
+
+	return MapStats{
+		Hits:     st.hits.Load(),
+		Misses:   st.misses.Load(),
+		Sets:     st.sets.Load(),
+		Deletes:  st.deletes.Load(),
+		LockWait: time.Duration(st.lockWait.Load()),
+	}
+}
+
+// lockWrite acquires the write lock, recording the wait if stats are enabled.
+func (m *Map[K, V]) lockWrite() {
+	st := m.stats.Load()
+	if st == nil {
+		m.lock.Lock()
+		return
+	}
+
+	start := time.Now()
+	m.lock.Lock()
+	st.lockWait.Add(int64(time.Since(start)))
+}
+
+// lockRead acquires the read lock, recording the wait if stats are enabled.
+func (m *Map[K, V]) lockRead() {
+	st := m.stats.Load()
+	if st == nil {
+		m.lock.RLock()
+		return
+	}
+
+	start := time.Now()
+	m.lock.RLock()
+	st.lockWait.Add(int64(time.Since(start)))
+}
+
+// recordGet counts a Get hit or miss if stats are enabled.
+func (m *Map[K, V]) recordGet(hit bool) {
+	st := m.stats.Load()
+	if st == nil {
+		return
+	}
+
+	if hit {
+		st.hits.Add(1)
+	} else {
+		st.misses.Add(1)
+	}
+}
@@ -0,0 +1,53 @@
+package threadsafe
+
+// MapTx provides unlocked access to a Map for the duration of a Map.Tx call. It must not be used after the call
+// returns.
+type MapTx[K comparable, V any] struct {
+	m *Map[K, V]
+}
+
+// Tx runs f with the map locked, so that every read and write made through tx happens as a single atomic operation.
+// Writes made through tx notify watchers, run OnSet and OnDelete hooks and update stats in the same way as the
+// equivalent Map methods. f must not call methods on the map itself, or it will deadlock.
+func (m *Map[K, V]) Tx(f func(tx MapTx[K, V])) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	f(MapTx[K, V]{m: m})
+}
+
+// WithLock runs f with the map locked, passing it the underlying map to read or modify directly. Changes made this way
+// bypass watchers, the OnSet, OnDelete and OnEmpty hooks and the stats counters; use Tx if they need to be observed. f
+// must not call methods on the map, or it will deadlock.
+func (m *Map[K, V]) WithLock(f func(data map[K]V)) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	if m.Data == nil {
+		m.Data = make(map[K]V)
+	}
+
+	f(m.Data)
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
+func (tx MapTx[K, V]) Get(key K) (V, bool) {
+	v, ok := tx.m.Data[key]
+
+	return tx.m.copyValue(v), ok
+}
+
+// Set writes the value V at key K.
+func (tx MapTx[K, V]) Set(key K, value V) {
+	tx.m.set(key, value)
+}
+
+// Delete deletes the key K, if it exists.
+func (tx MapTx[K, V]) Delete(key K) {
+	tx.m.delete(key)
+}
+
+// Len returns the length of the map.
+func (tx MapTx[K, V]) Len() int {
+	return len(tx.m.Data)
+}
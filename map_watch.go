@@ -0,0 +1,163 @@
+package threadsafe
+
+import (
+	"context"
+	"sync"
+)
+
+// watchBuffer is the channel buffer size for each Map watcher.
+const watchBuffer = 64
+
+// MapEvent describes a change to a single key of a Map. For a deletion, Value holds the value that was removed.
+type MapEvent[K comparable, V any] struct {
+	Key     K
+	Value   V
+	Deleted bool
+}
+
+type mapWatchers[K comparable, V any] struct {
+	byKey map[K]map[*mapWatcher[K, V]]struct{}
+	all   map[*mapWatcher[K, V]]struct{}
+}
+
+type mapWatcher[K comparable, V any] struct {
+	ch chan MapEvent[K, V]
+}
+
+// Watch returns a channel that receives an event each time key K is set or deleted, and a function that stops the
+// watch and closes the channel. Events are sent without blocking while the map is locked, so if the channel's buffer
+// is full the event is dropped; consumers should keep up or re-read the map after draining.
+func (m *Map[K, V]) Watch(key K) (<-chan MapEvent[K, V], func()) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	return m.watch(key)
+}
+
+// watch registers a watcher for key K. The caller must hold the write lock.
+func (m *Map[K, V]) watch(key K) (<-chan MapEvent[K, V], func()) {
+	w := &mapWatcher[K, V]{ch: make(chan MapEvent[K, V], watchBuffer)}
+
+	ws := m.initWatchers()
+	if ws.byKey[key] == nil {
+		ws.byKey[key] = make(map[*mapWatcher[K, V]]struct{})
+	}
+	ws.byKey[key][w] = struct{}{}
+
+	return w.ch, m.unwatchFunc(w, func() {
+		delete(ws.byKey[key], w)
+		if len(ws.byKey[key]) == 0 {
+			delete(ws.byKey, key)
+		}
+	})
+}
+
+// WatchAll behaves like Watch but receives events for every key in the map.
+func (m *Map[K, V]) WatchAll() (<-chan MapEvent[K, V], func()) {
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	w := &mapWatcher[K, V]{ch: make(chan MapEvent[K, V], watchBuffer)}
+
+	ws := m.initWatchers()
+	ws.all[w] = struct{}{}
+
+	return w.ch, m.unwatchFunc(w, func() {
+		delete(ws.all, w)
+	})
+}
+
+// GetWait returns the value V at key K, blocking until the key is set if it does not exist yet. It returns ctx.Err() if
+// the context is done before that happens.
+func (m *Map[K, V]) GetWait(ctx context.Context, key K) (V, error) {
+	m.lockWrite()
+	if v, ok := m.Data[key]; ok {
+		v = m.copyValue(v)
+		m.unlockWrite()
+		return v, nil
+	}
+
+	ch, cancel := m.watch(key)
+	m.unlockWrite()
+
+	defer cancel()
+
+	for {
+		select {
+		case e := <-ch:
+			if !e.Deleted {
+				return e.Value, nil
+			}
+		case <-ctx.Done():
+			return *new(V), ctx.Err()
+		}
+	}
+}
+
+func (m *Map[K, V]) initWatchers() *mapWatchers[K, V] {
+	if m.watchers == nil {
+		m.watchers = &mapWatchers[K, V]{
+			byKey: make(map[K]map[*mapWatcher[K, V]]struct{}),
+			all:   make(map[*mapWatcher[K, V]]struct{}),
+		}
+	}
+
+	return m.watchers
+}
+
+// unwatchFunc returns a cancel function that runs remove under the lock and closes the watcher's channel. It is safe
+// to call more than once.
+func (m *Map[K, V]) unwatchFunc(w *mapWatcher[K, V], remove func()) func() {
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			m.lockWrite()
+			defer m.unlockWrite()
+
+			remove()
+			close(w.ch)
+		})
+	}
+}
+
+// notify sends e to every watcher of its key. The caller must hold the write lock.
+func (m *Map[K, V]) notify(e MapEvent[K, V]) {
+	if m.watchers == nil {
+		return
+	}
+
+	for w := range m.watchers.byKey[e.Key] {
+		w.send(m.copyEvent(e))
+	}
+	for w := range m.watchers.all {
+		w.send(m.copyEvent(e))
+	}
+}
+
+// notifyAllDeleted sends a deletion event for every entry in data. The caller must hold the write lock.
+func (m *Map[K, V]) notifyAllDeleted(data map[K]V) {
+	if m.watchers == nil {
+		return
+	}
+
+	for k, v := range data {
+		m.notify(MapEvent[K, V]{Key: k, Value: v, Deleted: true})
+	}
+}
+
+// copyEvent returns e with the value of a write passed through copyValue, so each watcher gets its own copy.
+func (m *Map[K, V]) copyEvent(e MapEvent[K, V]) MapEvent[K, V] {
+	if !e.Deleted {
+		e.Value = m.copyValue(e.Value)
+	}
+
+	return e
+}
+
+func (w *mapWatcher[K, V]) send(e MapEvent[K, V]) {
+	select {
+	case w.ch <- e:
+	default:
+	}
+}
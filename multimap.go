@@ -0,0 +1,136 @@
+package threadsafe
+
+import (
+	"slices"
+	"sync"
+)
+
+// MultiMap represents a generic map from each key to a list of values. Values are appended per key under the map's
+// lock, avoiding the lost updates of a Get, append, Set sequence on a Map[K, []V].
+type MultiMap[K comparable, V any] struct {
+	data map[K][]V
+	lock sync.RWMutex
+}
+
+func NewMultiMap[K comparable, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		data: make(map[K][]V),
+	}
+}
+
+// Add appends values to the list at key K.
+func (m *MultiMap[K, V]) Add(key K, values ...V) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.data[key] = append(m.data[key], values...)
+}
+
+// GetAll returns a copy of the values at key K, in the order they were added. Returns nil if the key does not exist.
+func (m *MultiMap[K, V]) GetAll(key K) []V {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	values, ok := m.data[key]
+	if !ok {
+		return nil
+	}
+
+	return append([]V(nil), values...)
+}
+
+// RemoveValue removes the first value at key K equal to value. It panics if the values are not comparable; use
+// RemoveFunc to supply a custom match. Returns true if a value was removed.
+func (m *MultiMap[K, V]) RemoveValue(key K, value V) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for i, v := range m.data[key] {
+		if equalAny(v, value) {
+			m.removeAt(key, i)
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveFunc removes every value at key K for which f returns true. Returns the number of values removed.
+func (m *MultiMap[K, V]) RemoveFunc(key K, f func(V) bool) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	values := m.data[key]
+
+	kept := values[:0]
+	for _, v := range values {
+		if !f(v) {
+			kept = append(kept, v)
+		}
+	}
+	clear(values[len(kept):])
+
+	if len(kept) == 0 {
+		delete(m.data, key)
+	} else if len(kept) != len(values) {
+		m.data[key] = kept
+	}
+
+	return len(values) - len(kept)
+}
+
+// removeAt removes the value at index i of key K, deleting the key once it has no values left.
+func (m *MultiMap[K, V]) removeAt(key K, i int) {
+	values := slices.Delete(m.data[key], i, i+1)
+	if len(values) == 0 {
+		delete(m.data, key)
+		return
+	}
+
+	m.data[key] = values
+}
+
+// Delete deletes the key K and all of its values, if it exists.
+func (m *MultiMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.data, key)
+}
+
+// CountValues returns the number of values at key K.
+func (m *MultiMap[K, V]) CountValues(key K) int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.data[key])
+}
+
+// Keys returns a slice of K keys.
+func (m *MultiMap[K, V]) Keys() []K {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]K, 0, len(m.data))
+	for k := range m.data {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Empty deletes all keys in the map.
+func (m *MultiMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.data = make(map[K][]V)
+}
+
+// Len returns the number of keys in the map.
+func (m *MultiMap[K, V]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.data)
+}
@@ -0,0 +1,129 @@
+package threadsafe
+
+import "sync"
+
+// Multiset represents a generic bag of comparable values, where each value may be present more than once. Unlike
+// Counter, counts never drop below zero and a value is forgotten as soon as its count reaches zero. The zero value is
+// an empty multiset ready to use.
+type Multiset[T comparable] struct {
+	counts map[T]int
+	size   int
+	lock   sync.RWMutex
+}
+
+func NewMultiset[T comparable]() *Multiset[T] {
+	return &Multiset[T]{
+		counts: make(map[T]int),
+	}
+}
+
+// Add adds n occurrences of v and returns the new count of v. A non-positive n leaves the multiset unchanged.
+func (m *Multiset[T]) Add(v T, n int) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if n <= 0 {
+		return m.counts[v]
+	}
+
+	if m.counts == nil {
+		m.counts = make(map[T]int)
+	}
+
+	m.counts[v] += n
+	m.size += n
+
+	return m.counts[v]
+}
+
+// Remove removes up to n occurrences of v and returns the remaining count of v. A non-positive n leaves the multiset
+// unchanged.
+func (m *Multiset[T]) Remove(v T, n int) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c := m.counts[v]
+	if n <= 0 || c == 0 {
+		return c
+	}
+
+	n = min(n, c)
+	m.size -= n
+
+	if c == n {
+		delete(m.counts, v)
+		return 0
+	}
+
+	m.counts[v] = c - n
+
+	return c - n
+}
+
+// RemoveAll removes every occurrence of v and returns how many there were.
+func (m *Multiset[T]) RemoveAll(v T) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	c := m.counts[v]
+	delete(m.counts, v)
+	m.size -= c
+
+	return c
+}
+
+// Count returns the number of occurrences of v.
+func (m *Multiset[T]) Count(v T) int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.counts[v]
+}
+
+// Contains reports whether v occurs at least once.
+func (m *Multiset[T]) Contains(v T) bool {
+	return m.Count(v) > 0
+}
+
+// Distinct returns each value that occurs at least once, in an unspecified order.
+func (m *Multiset[T]) Distinct() []T {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	values := make([]T, 0, len(m.counts))
+	for v := range m.counts {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Counts returns a copy of the count of every value.
+func (m *Multiset[T]) Counts() map[T]int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	counts := make(map[T]int, len(m.counts))
+	for v, c := range m.counts {
+		counts[v] = c
+	}
+
+	return counts
+}
+
+// Empty removes every value from the multiset.
+func (m *Multiset[T]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.counts = make(map[T]int)
+	m.size = 0
+}
+
+// Len returns the total number of occurrences of all values.
+func (m *Multiset[T]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.size
+}
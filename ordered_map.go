@@ -0,0 +1,141 @@
+package threadsafe
+
+import (
+	"container/list"
+	"sync"
+)
+
+// OrderedMap represents a generic map that remembers the order in which keys were first set. Keys, Values and Range
+// all follow that order. Setting an existing key updates its value without moving it.
+type OrderedMap[K comparable, V any] struct {
+	items map[K]*list.Element
+	order *list.List
+	lock  sync.RWMutex
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		items: make(map[K]*list.Element),
+		order: list.New(),
+	}
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	el, ok := m.items[key]
+	if !ok {
+		return *new(V), false
+	}
+
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Set writes the value V at key K. New keys are added to the back of the order.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if el, ok := m.items[key]; ok {
+		el.Value.(*entry[K, V]).value = value
+		return
+	}
+
+	m.items[key] = m.order.PushBack(&entry[K, V]{key: key, value: value})
+}
+
+// Delete deletes the key K, if it exists.
+func (m *OrderedMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if el, ok := m.items[key]; ok {
+		m.order.Remove(el)
+		delete(m.items, key)
+	}
+}
+
+// MoveToFront moves the key K to the front of the order. Returns false if the key does not exist.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	el, ok := m.items[key]
+	if ok {
+		m.order.MoveToFront(el)
+	}
+
+	return ok
+}
+
+// MoveToBack moves the key K to the back of the order. Returns false if the key does not exist.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	el, ok := m.items[key]
+	if ok {
+		m.order.MoveToBack(el)
+	}
+
+	return ok
+}
+
+// Keys returns a slice of K keys in order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]K, 0, m.order.Len())
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		keys = append(keys, el.Value.(*entry[K, V]).key)
+	}
+
+	return keys
+}
+
+// Values returns a slice of V values in key order.
+func (m *OrderedMap[K, V]) Values() []V {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	values := make([]V, 0, m.order.Len())
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		values = append(values, el.Value.(*entry[K, V]).value)
+	}
+
+	return values
+}
+
+// Range calls f sequentially for each key and value in order. If f returns false, Range stops the iteration. The map
+// is locked for the duration of the call, so f must not call other methods on the map.
+func (m *OrderedMap[K, V]) Range(f func(key K, value V) bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	for el := m.order.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*entry[K, V])
+		if !f(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// Empty deletes all keys in the map.
+func (m *OrderedMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.items = make(map[K]*list.Element)
+	m.order.Init()
+}
+
+// Len returns the length of the map.
+func (m *OrderedMap[K, V]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.order.Len()
+}
@@ -0,0 +1,155 @@
+package threadsafe
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Persister encodes a snapshot of a map's contents to a writer and decodes it back from a reader.
+type Persister[K comparable, V any] interface {
+	Save(w io.Writer, data map[K]V) error
+	Load(r io.Reader) (map[K]V, error)
+}
+
+// GobPersister is a Persister using encoding/gob.
+type GobPersister[K comparable, V any] struct{}
+
+// Save implements Persister.
+func (GobPersister[K, V]) Save(w io.Writer, data map[K]V) error {
+	return gob.NewEncoder(w).Encode(data)
+}
+
+// Load implements Persister.
+func (GobPersister[K, V]) Load(r io.Reader) (map[K]V, error) {
+	var data map[K]V
+	err := gob.NewDecoder(r).Decode(&data)
+
+	return data, err
+}
+
+// JSONPersister is a Persister using encoding/json.
+type JSONPersister[K comparable, V any] struct{}
+
+// Save implements Persister.
+func (JSONPersister[K, V]) Save(w io.Writer, data map[K]V) error {
+	return json.NewEncoder(w).Encode(data)
+}
+
+// Load implements Persister.
+func (JSONPersister[K, V]) Load(r io.Reader) (map[K]V, error) {
+	var data map[K]V
+	err := json.NewDecoder(r).Decode(&data)
+
+	return data, err
+}
+
+// SaveTo writes a snapshot of the map to w using p. The map is only locked while the snapshot is taken, not while it
+// is written.
+func (m *Map[K, V]) SaveTo(w io.Writer, p Persister[K, V]) error {
+	return p.Save(w, m.Snapshot())
+}
+
+// LoadFrom replaces the map's contents with those read from r using p. If loading fails the map is left unchanged.
+func (m *Map[K, V]) LoadFrom(r io.Reader, p Persister[K, V]) error {
+	data, err := p.Load(r)
+	if err != nil {
+		return err
+	}
+
+	m.lockWrite()
+	defer m.unlockWrite()
+
+	m.replace(data)
+
+	return nil
+}
+
+// SaveFile writes a snapshot of the map to the file at path using p. The snapshot is written and synced to a temporary
+// file in the same directory first and then renamed over path, so a crash or power loss never leaves a partially
+// written file behind. An existing file keeps its permissions; a new file is created with mode 0644.
+func (m *Map[K, V]) SaveFile(path string, p Persister[K, V]) error {
+	mode := os.FileMode(0o644)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err = m.SaveTo(f, p); err == nil {
+		err = f.Chmod(mode)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// LoadFile replaces the map's contents with those read from the file at path using p.
+func (m *Map[K, V]) LoadFile(path string, p Persister[K, V]) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return m.LoadFrom(f, p)
+}
+
+// AutoSave saves the map to the file at path with SaveFile every interval, in a background goroutine. Errors from
+// periodic saves are passed to onError, if it is not nil. The returned stop function stops the goroutine, performs one
+// final save and returns its error. It is safe to call stop more than once; later calls only repeat the final save.
+// AutoSave will panic if interval is not positive.
+func (m *Map[K, V]) AutoSave(path string, interval time.Duration, p Persister[K, V], onError func(error)) (stop func() error) {
+	if interval <= 0 {
+		panic("threadsafe: AutoSave interval must be positive")
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.SaveFile(path, p); err != nil && onError != nil {
+					onError(err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+
+	return func() error {
+		once.Do(func() {
+			close(done)
+			<-stopped
+		})
+
+		return m.SaveFile(path, p)
+	}
+}
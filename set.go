@@ -0,0 +1,159 @@
+package threadsafe
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+)
+
+// Set represents a generic set of comparable values that locks itself on each operation. The zero value is an empty
+// set ready to use.
+type Set[T comparable] struct {
+	data map[T]struct{}
+	lock sync.RWMutex
+}
+
+func NewSet[T comparable]() *Set[T] {
+	return &Set[T]{
+		data: make(map[T]struct{}),
+	}
+}
+
+// NewSetFrom returns a Set containing the values in data. Duplicates are collapsed.
+func NewSetFrom[T comparable](data []T) *Set[T] {
+	s := &Set[T]{
+		data: make(map[T]struct{}, len(data)),
+	}
+	for _, v := range data {
+		s.data[v] = struct{}{}
+	}
+
+	return s
+}
+
+// NewSetFromSlice returns a Set containing the elements of s, read under a single lock.
+func NewSetFromSlice[T comparable](s *Slice[T]) *Set[T] {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return NewSetFrom(s.Data)
+}
+
+// NewSetFromKeys returns a Set containing the keys of m, read under a single lock.
+func NewSetFromKeys[K comparable, V any](m *Map[K, V]) *Set[K] {
+	m.lockRead()
+	defer m.lock.RUnlock()
+
+	s := &Set[K]{
+		data: make(map[K]struct{}, len(m.Data)),
+	}
+	for k := range m.Data {
+		s.data[k] = struct{}{}
+	}
+
+	return s
+}
+
+// Add adds the values v to the set.
+func (s *Set[T]) Add(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.data == nil {
+		s.data = make(map[T]struct{}, len(v))
+	}
+
+	for _, e := range v {
+		s.data[e] = struct{}{}
+	}
+}
+
+// Remove removes the values v from the set, if present.
+func (s *Set[T]) Remove(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, e := range v {
+		delete(s.data, e)
+	}
+}
+
+// Contains reports whether v is in the set.
+func (s *Set[T]) Contains(v T) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	_, ok := s.data[v]
+
+	return ok
+}
+
+// ToSlice returns the members of the set in an unspecified order.
+func (s *Set[T]) ToSlice() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	values := make([]T, 0, len(s.data))
+	for v := range s.data {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// ToSortedSlice returns the members of s in ascending order.
+func ToSortedSlice[T cmp.Ordered](s *Set[T]) []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return sortedKeys(s.data)
+}
+
+// Slice returns a new Slice containing the members of the set in an unspecified order.
+func (s *Set[T]) Slice() *Slice[T] {
+	return &Slice[T]{
+		Data: s.ToSlice(),
+	}
+}
+
+// Range calls f sequentially for each member of the set. If f returns false, Range stops the iteration. The set is
+// locked for the duration of the call, so f must not call other methods on the set.
+func (s *Set[T]) Range(f func(v T) bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for v := range s.data {
+		if !f(v) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the members of the set in an unspecified order. Each iteration ranges over a snapshot
+// taken when it starts, so the set is not locked while the loop body runs and later changes are not observed. Use
+// Range to avoid the copy when the callback is short and does not touch the set.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.ToSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Empty removes every member of the set.
+func (s *Set[T]) Empty() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data = make(map[T]struct{})
+}
+
+// Len returns the number of members in the set.
+func (s *Set[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return len(s.data)
+}
@@ -0,0 +1,29 @@
+package threadsafe
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler. The Set is encoded as a JSON array of its members in an unspecified order.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The Set's members are replaced by those of the decoded JSON array;
+// duplicates are collapsed. If decoding fails the Set is left unchanged.
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	var values []T
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+
+	data := make(map[T]struct{}, len(values))
+	for _, v := range values {
+		data[v] = struct{}{}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.data = data
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package threadsafe
+
+// Union returns a new set holding every member of s or other. Both sets are read-locked in a consistent order while
+// the result is built.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	result := &Set[T]{data: make(map[T]struct{}, max(len(s.data), len(other.data)))}
+	for v := range s.data {
+		result.data[v] = struct{}{}
+	}
+	for v := range other.data {
+		result.data[v] = struct{}{}
+	}
+
+	return result
+}
+
+// Intersect returns a new set holding every member of both s and other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	small, large := s.data, other.data
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := &Set[T]{data: make(map[T]struct{})}
+	for v := range small {
+		if _, ok := large[v]; ok {
+			result.data[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new set holding every member of s that is not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	result := &Set[T]{data: make(map[T]struct{})}
+	for v := range s.data {
+		if _, ok := other.data[v]; !ok {
+			result.data[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns a new set holding every member of exactly one of s and other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	result := &Set[T]{data: make(map[T]struct{})}
+	for v := range s.data {
+		if _, ok := other.data[v]; !ok {
+			result.data[v] = struct{}{}
+		}
+	}
+	for v := range other.data {
+		if _, ok := s.data[v]; !ok {
+			result.data[v] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// UnionWith adds every member of other to s. s is write-locked and other read-locked, in a consistent order.
+func (s *Set[T]) UnionWith(other *Set[T]) {
+	if s == other {
+		return
+	}
+
+	unlock := lockPair(&s.lock, other.lock.RLocker())
+	defer unlock()
+
+	if s.data == nil {
+		s.data = make(map[T]struct{}, len(other.data))
+	}
+
+	for v := range other.data {
+		s.data[v] = struct{}{}
+	}
+}
+
+// IntersectWith removes every member of s that is not in other.
+func (s *Set[T]) IntersectWith(other *Set[T]) {
+	if s == other {
+		return
+	}
+
+	unlock := lockPair(&s.lock, other.lock.RLocker())
+	defer unlock()
+
+	for v := range s.data {
+		if _, ok := other.data[v]; !ok {
+			delete(s.data, v)
+		}
+	}
+}
+
+// DifferenceWith removes every member of other from s.
+func (s *Set[T]) DifferenceWith(other *Set[T]) {
+	if s == other {
+		s.Empty()
+		return
+	}
+
+	unlock := lockPair(&s.lock, other.lock.RLocker())
+	defer unlock()
+
+	for v := range other.data {
+		delete(s.data, v)
+	}
+}
+
+// SymmetricDifferenceWith leaves s holding every member of exactly one of s and other.
+func (s *Set[T]) SymmetricDifferenceWith(other *Set[T]) {
+	if s == other {
+		s.Empty()
+		return
+	}
+
+	unlock := lockPair(&s.lock, other.lock.RLocker())
+	defer unlock()
+
+	if s.data == nil {
+		s.data = make(map[T]struct{}, len(other.data))
+	}
+
+	for v := range other.data {
+		if _, ok := s.data[v]; ok {
+			delete(s.data, v)
+		} else {
+			s.data[v] = struct{}{}
+		}
+	}
+}
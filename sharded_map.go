@@ -0,0 +1,101 @@
+package threadsafe
+
+import (
+	"hash/maphash"
+	"runtime"
+)
+
+// ShardedMap represents a generic map split across several independently locked Map shards. Keys are distributed
+// between shards by hash, so operations on different keys usually contend on different locks. This scales better than
+// a single Map when many goroutines write concurrently.
+type ShardedMap[K comparable, V any] struct {
+	shards []*Map[K, V]
+	seed   maphash.Seed
+}
+
+// NewShardedMap returns a ShardedMap with the given number of shards. If shards is less than 1, runtime.GOMAXPROCS(0)
+// shards are used.
+func NewShardedMap[K comparable, V any](shards int) *ShardedMap[K, V] {
+	if shards < 1 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+
+	m := &ShardedMap[K, V]{
+		shards: make([]*Map[K, V], shards),
+		seed:   maphash.MakeSeed(),
+	}
+	for i := range m.shards {
+		m.shards[i] = NewMap[K, V]()
+	}
+
+	return m
+}
+
+// shard returns the shard responsible for key K.
+func (m *ShardedMap[K, V]) shard(key K) *Map[K, V] {
+	return m.shards[maphash.Comparable(m.seed, key)%uint64(len(m.shards))]
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
+func (m *ShardedMap[K, V]) Get(key K) (V, bool) {
+	return m.shard(key).Get(key)
+}
+
+// Set writes the value V at key K.
+func (m *ShardedMap[K, V]) Set(key K, value V) {
+	m.shard(key).Set(key, value)
+}
+
+// Delete deletes the key K, if it exists.
+func (m *ShardedMap[K, V]) Delete(key K) {
+	m.shard(key).Delete(key)
+}
+
+// Range calls f sequentially for each key and value in the map. If f returns false, Range stops the iteration. Each
+// shard is locked only while it is being iterated, so Range does not observe a single consistent snapshot of the whole
+// map. f must not call other methods on the map.
+func (m *ShardedMap[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range m.shards {
+		stopped := false
+		s.Range(func(k K, v V) bool {
+			if !f(k, v) {
+				stopped = true
+				return false
+			}
+
+			return true
+		})
+
+		if stopped {
+			return
+		}
+	}
+}
+
+// Keys returns a slice of K keys.
+func (m *ShardedMap[K, V]) Keys() []K {
+	var keys []K
+	for _, s := range m.shards {
+		keys = append(keys, s.Keys()...)
+	}
+
+	return keys
+}
+
+// Empty deletes all keys in the map.
+func (m *ShardedMap[K, V]) Empty() {
+	for _, s := range m.shards {
+		s.Empty()
+	}
+}
+
+// Len returns the length of the map. Shards are counted one at a time, so the result may be stale under concurrent
+// writes.
+func (m *ShardedMap[K, V]) Len() int {
+	n := 0
+	for _, s := range m.shards {
+		n += s.Len()
+	}
+
+	return n
+}
@@ -1,52 +1,124 @@
 package threadsafe
 
 import (
+	"iter"
+	"math/rand/v2"
+	"slices"
 	"sync"
 )
 
+// Slice represents a generic slice that locks itself on each operation. Read-only operations take a shared read lock,
+// so they can run concurrently with each other. The underlying slice Data is left exposed to not block any potential
+// operations that might be needed, but should generally not be touched directly.
 type Slice[T any] struct {
-	Data []T
-	lock sync.Mutex
+	Data        []T
+	lock        sync.RWMutex
+	subscribers map[chan T]struct{}
+	bound       *sliceBound
 }
 
-// Append appends the value v into Slice.
-func (s *Slice[T]) Append(v T) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+func NewSlice[T any]() *Slice[T] {
+	return &Slice[T]{}
+}
 
-	s.Data = append(s.Data, v)
+// NewSliceWithCapacity returns an empty Slice whose underlying slice is preallocated to hold n elements.
+func NewSliceWithCapacity[T any](n int) *Slice[T] {
+	return &Slice[T]{
+		Data: make([]T, 0, n),
+	}
+}
+
+// NewSliceFrom returns a Slice containing a copy of data. Later changes to data are not reflected in the Slice.
+func NewSliceFrom[T any](data []T) *Slice[T] {
+	return &Slice[T]{
+		Data: append(make([]T, 0, len(data)), data...),
+	}
 }
 
-func (s *Slice[T]) Insert(index int, v T) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+// Append appends the values v into Slice under a single lock. To append a whole slice, use Append(vs...). If a maximum
+// length has been set with SetMaxLen, appends past it are handled by its OverflowPolicy, and Append returns false if
+// they were rejected. Otherwise it always returns true.
+func (s *Slice[T]) Append(v ...T) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if s.bound == nil {
+		s.Data = append(s.Data, v...)
+	} else if !s.appendBounded(v) {
+		return false
+	}
+
+	s.publish(v)
+
+	return true
+}
+
+// Insert inserts v at index, shifting later elements up. Insert will panic if index is out of bounds. If a maximum
+// length has been set with SetMaxLen, Insert follows its OverflowPolicy and returns false if the insert was rejected.
+func (s *Slice[T]) Insert(index int, v T) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if !s.makeRoom(1) {
+		return false
+	}
 
 	s.Data = append(s.Data[:index], append([]T{v}, s.Data[index:]...)...)
+	s.fit()
+
+	return true
 }
 
+// SafeInsert behaves like Insert but returns false, leaving the Slice unchanged, if index is out of range.
 func (s *Slice[T]) SafeInsert(index int, v T) bool {
-	if index < 0 || index > len(s.Data) {
+	return s.InsertAll(index, v)
+}
+
+// InsertAll inserts the values vs at index under a single lock, shifting later elements up. Returns false, leaving the
+// Slice unchanged, if index is out of range or the insert was rejected by the OverflowPolicy set with SetMaxLen.
+func (s *Slice[T]) InsertAll(index int, vs ...T) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if !s.makeRoom(len(vs)) || index < 0 || index > len(s.Data) {
 		return false
 	}
 
-	s.lock.Lock()
-	defer s.lock.Unlock()
-
-	s.Data = append(s.Data[:index], append([]T{v}, s.Data[index:]...)...)
+	s.Data = slices.Insert(s.Data, index, vs...)
+	s.fit()
 
 	return true
 }
 
+// SortedInsert inserts v into the Slice, which must be sorted in increasing order by cmp, at the position that keeps
+// it sorted. The search and insert happen under a single lock. Returns the index v was inserted at, or -1 if the insert
+// was rejected by the OverflowPolicy set with SetMaxLen. Under OverflowDropOldest, the returned index is the one v was
+// inserted at before any elements were dropped.
+func (s *Slice[T]) SortedInsert(v T, cmp func(a, b T) int) int {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if !s.makeRoom(1) {
+		return -1
+	}
+
+	i, _ := slices.BinarySearchFunc(s.Data, v, cmp)
+	s.Data = slices.Insert(s.Data, i, v)
+	s.fit()
+
+	return i
+}
+
 func (s *Slice[T]) Replace(index int, v T) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lockWrite()
+	defer s.unlockWrite()
 
 	s.Data[index] = v
 }
 
 func (s *Slice[T]) SafeReplace(index int, v T) bool {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lockWrite()
+	defer s.unlockWrite()
 
 	if index < 0 || index >= len(s.Data) {
 		return false
@@ -57,23 +129,121 @@ func (s *Slice[T]) SafeReplace(index int, v T) bool {
 	return true
 }
 
+// Swap swaps the elements at indexes i and j. Swap will panic if either index is out of bounds. If a panic is
+// undesired, use SafeSwap.
+func (s *Slice[T]) Swap(i, j int) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.swap(i, j)
+}
+
+// SafeSwap behaves like Swap but returns false instead of panicking if either index is out of bounds.
+func (s *Slice[T]) SafeSwap(i, j int) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if i < 0 || i >= len(s.Data) || j < 0 || j >= len(s.Data) {
+		return false
+	}
+
+	s.swap(i, j)
+
+	return true
+}
+
 func (s *Slice[T]) Get(index int) T {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
 	return s.Data[index]
 }
 
+// First returns the first element of the Slice. The boolean is false if the Slice is empty.
+func (s *Slice[T]) First() (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	return s.Data[0], true
+}
+
+// Last returns the last element of the Slice. The boolean is false if the Slice is empty.
+func (s *Slice[T]) Last() (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	return s.Data[len(s.Data)-1], true
+}
+
+// GetAll returns a copy of every element in the Slice. It is equivalent to Snapshot.
 func (s *Slice[T]) GetAll() []T {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	return s.Snapshot()
+}
+
+// SubSlice returns a copy of the elements in [from, to), taken under the lock. Returns false if the range is out of
+// bounds.
+func (s *Slice[T]) SubSlice(from, to int) ([]T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.validRange(from, to) {
+		return nil, false
+	}
+
+	return append([]T(nil), s.Data[from:to]...), true
+}
+
+// Chunk returns the elements of the Slice split into consecutive chunks of size elements, copied under a single lock.
+// The last chunk may be shorter. Chunk panics if size is less than 1.
+func (s *Slice[T]) Chunk(size int) [][]T {
+	if size < 1 {
+		panic("threadsafe: Chunk size must be at least 1")
+	}
+
+	data := s.Snapshot()
+
+	chunks := make([][]T, 0, (len(data)+size-1)/size)
+	for c := range slices.Chunk(data, size) {
+		chunks = append(chunks, c)
+	}
+
+	return chunks
+}
+
+// validRange reports whether [from, to) is a valid range of the Slice. The caller must hold the lock.
+func (s *Slice[T]) validRange(from, to int) bool {
+	return from >= 0 && from <= to && to <= len(s.Data)
+}
+
+// Snapshot returns a copy of the underlying slice taken under a single lock. The copy can be read and modified freely
+// without affecting the Slice.
+func (s *Slice[T]) Snapshot() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return append([]T(nil), s.Data...)
+}
+
+// UnsafeData returns the underlying slice without copying it. The result shares its backing array with the Slice and
+// is not protected by the lock, so it must not be used while other goroutines may modify the Slice.
+func (s *Slice[T]) UnsafeData() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
 	return s.Data
 }
 
 func (s *Slice[T]) SafeGet(index int) (T, bool) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
 	if index < 0 || index >= len(s.Data) {
 		return *new(T), false
@@ -84,33 +254,345 @@ func (s *Slice[T]) SafeGet(index int) (T, bool) {
 
 // Delete deletes the item at index i. Delete will panic if i is out of bounds. If a panic is undesired, use SafeDelete.
 func (s *Slice[T]) Delete(index int) {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lockWrite()
+	defer s.unlockWrite()
 
 	s.Data = append(s.Data[:index], s.Data[index+1:]...)
 }
 
 func (s *Slice[T]) SafeDelete(index int) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
 	if index < 0 || index >= len(s.Data) {
 		return false
 	}
 
-	s.lock.Lock()
-	defer s.lock.Unlock()
-
 	s.Data = append(s.Data[:index], s.Data[index+1:]...)
 	return true
 }
 
+// SwapDelete deletes the element at index in O(1) by moving the last element into its place, so the order of the
+// remaining elements is not preserved. SwapDelete will panic if index is out of bounds. If a panic is undesired, use
+// SafeSwapDelete.
+func (s *Slice[T]) SwapDelete(index int) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.swapDelete(index)
+}
+
+// SafeSwapDelete behaves like SwapDelete but returns false instead of panicking if index is out of bounds.
+func (s *Slice[T]) SafeSwapDelete(index int) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if index < 0 || index >= len(s.Data) {
+		return false
+	}
+
+	s.swapDelete(index)
+
+	return true
+}
+
+func (s *Slice[T]) swapDelete(index int) {
+	last := len(s.Data) - 1
+	s.Data[index] = s.Data[last]
+	s.Data[last] = *new(T)
+	s.Data = s.Data[:last]
+}
+
+// DeleteRange deletes the elements in [from, to) under a single lock. Returns false, leaving the Slice unchanged, if
+// the range is out of bounds.
+func (s *Slice[T]) DeleteRange(from, to int) bool {
+	_, ok := s.Splice(from, to)
+
+	return ok
+}
+
+// Splice behaves like DeleteRange but also returns a copy of the removed elements.
+func (s *Slice[T]) Splice(from, to int) ([]T, bool) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if !s.validRange(from, to) {
+		return nil, false
+	}
+
+	removed := append([]T(nil), s.Data[from:to]...)
+	s.Data = slices.Delete(s.Data, from, to)
+
+	return removed, true
+}
+
+// DeleteFunc deletes every element for which f returns true in a single locked pass. Returns the number of elements
+// deleted.
+func (s *Slice[T]) DeleteFunc(f func(T) bool) int {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	n := len(s.Data)
+	s.Data = slices.DeleteFunc(s.Data, f)
+
+	return n - len(s.Data)
+}
+
+// CompactFunc replaces each run of consecutive elements that eq reports as equal with the first of the run, in the
+// manner of slices.CompactFunc. Sort the Slice first to remove every duplicate. Returns the number of elements removed.
+func (s *Slice[T]) CompactFunc(eq func(a, b T) bool) int {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	n := len(s.Data)
+	s.Data = slices.CompactFunc(s.Data, eq)
+
+	return n - len(s.Data)
+}
+
+// Pop removes and returns the last element of the Slice. The boolean is false if the Slice was empty.
+func (s *Slice[T]) Pop() (T, bool) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	last := len(s.Data) - 1
+	v := s.Data[last]
+	s.Data[last] = *new(T)
+	s.Data = s.Data[:last]
+
+	return v, true
+}
+
+// PopFront removes and returns the first element of the Slice. The boolean is false if the Slice was empty.
+func (s *Slice[T]) PopFront() (T, bool) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	v := s.Data[0]
+	s.Data[0] = *new(T)
+	s.Data = s.Data[1:]
+
+	return v, true
+}
+
 func (s *Slice[T]) Empty() {
-	s.lock.Lock()
+	s.lockWrite()
+	s.Data = nil
+	s.unlockWrite()
+}
+
+// WithLock runs f with the Slice locked, passing it the underlying slice, and stores the slice f returns. This allows
+// arbitrary compound operations to happen atomically. f must not keep a reference to data after it returns, and must
+// not call methods on the Slice, or it will deadlock.
+//
+// If a maximum length has been set with SetMaxLen and f returns a longer slice, it is trimmed to fit: from the front
+// under OverflowDropOldest, and otherwise from the end, in which case WithLock returns false.
+func (s *Slice[T]) WithLock(f func(data []T) []T) bool {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.Data = f(s.Data)
+
+	if s.bound == nil || len(s.Data) <= s.bound.max {
+		return true
+	}
+
+	if s.bound.policy == OverflowDropOldest {
+		s.dropOldest()
+		return true
+	}
+
+	clear(s.Data[s.bound.max:])
+	s.Data = s.Data[:s.bound.max]
+
+	return false
+}
+
+// Grow increases the capacity of the Slice, if necessary, to guarantee space for another n elements, in the manner of
+// slices.Grow. Grow panics if n is negative.
+func (s *Slice[T]) Grow(n int) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.Data = slices.Grow(s.Data, n)
+}
+
+// Truncate shortens the Slice to its first n elements, keeping its capacity. It does nothing if the Slice already has
+// n or fewer elements. Truncate panics if n is negative.
+func (s *Slice[T]) Truncate(n int) {
+	if n < 0 {
+		panic("threadsafe: Truncate length must not be negative")
+	}
+
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if n < len(s.Data) {
+		clear(s.Data[n:])
+		s.Data = s.Data[:n]
+	}
+}
+
+// Clip removes unused capacity from the Slice, in the manner of slices.Clip.
+func (s *Slice[T]) Clip() {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.Data = slices.Clip(s.Data)
+}
+
+// Cap returns the capacity of the Slice.
+func (s *Slice[T]) Cap() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return cap(s.Data)
+}
+
+// Drain returns the underlying slice and replaces it with an empty one under a single lock.
+func (s *Slice[T]) Drain() []T {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	data := s.Data
 	s.Data = nil
-	s.lock.Unlock()
+
+	return data
+}
+
+// Range calls f sequentially for each index and element in the Slice. If f returns false, Range stops the iteration.
+// The Slice is locked for the duration of the call, so f must not call other methods on the Slice.
+func (s *Slice[T]) Range(f func(i int, v T) bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for i, v := range s.Data {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over the indexes and elements of the Slice. Each iteration ranges over a snapshot taken when
+// it starts, so the Slice is not locked while the loop body runs and later changes are not observed.
+func (s *Slice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s.Snapshot() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over the elements of the Slice, with the same snapshot semantics as All.
+func (s *Slice[T]) ValuesSeq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.Snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Sort sorts the Slice in place under the lock, ordering a before b whenever less(a, b) is true. The sort is not
+// guaranteed to be stable.
+func (s *Slice[T]) Sort(less func(a, b T) bool) {
+	s.SortFunc(func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// SortFunc sorts the Slice in place under the lock, as determined by cmp in the manner of slices.SortFunc. The sort is
+// not guaranteed to be stable.
+func (s *Slice[T]) SortFunc(cmp func(a, b T) int) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	slices.SortFunc(s.Data, cmp)
+}
+
+// SortStableFunc behaves like SortFunc but keeps equal elements in their original order.
+func (s *Slice[T]) SortStableFunc(cmp func(a, b T) int) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	slices.SortStableFunc(s.Data, cmp)
+}
+
+// Reverse reverses the order of the elements in place under the lock.
+func (s *Slice[T]) Reverse() {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	slices.Reverse(s.Data)
+}
+
+// Shuffle pseudo-randomizes the order of the elements in place under the lock, using the default source of
+// math/rand/v2.
+func (s *Slice[T]) Shuffle() {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	rand.Shuffle(len(s.Data), s.swap)
+}
+
+// ShuffleSource behaves like Shuffle but draws randomness from src, for example to get a reproducible order in tests.
+func (s *Slice[T]) ShuffleSource(src rand.Source) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	rand.New(src).Shuffle(len(s.Data), s.swap)
+}
+
+// Sample returns n distinct elements of the Slice chosen at random, copied under a single lock and in random order. If
+// n is greater than the length of the Slice, every element is returned. The Slice itself is left unchanged.
+func (s *Slice[T]) Sample(n int) []T {
+	data := s.Snapshot()
+	n = max(0, min(n, len(data)))
+
+	// Partial Fisher-Yates shuffle: only the first n positions need to be chosen.
+	for i := 0; i < n; i++ {
+		j := i + rand.IntN(len(data)-i)
+		data[i], data[j] = data[j], data[i]
+	}
+
+	return data[:n:n]
+}
+
+func (s *Slice[T]) swap(i, j int) {
+	s.Data[i], s.Data[j] = s.Data[j], s.Data[i]
+}
+
+// BinarySearchFunc searches the Slice, which must be sorted in increasing order by cmp, for target. It returns the
+// index where target is found, or where it would be inserted, and whether it was found, in the manner of
+// slices.BinarySearchFunc.
+func (s *Slice[T]) BinarySearchFunc(target T, cmp func(a, b T) int) (int, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return slices.BinarySearchFunc(s.Data, target, cmp)
 }
 
 func (s *Slice[T]) IndexFunc(f func(T) bool) int {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
 	for i, v := range s.Data {
 		if f(v) {
@@ -121,9 +603,269 @@ func (s *Slice[T]) IndexFunc(f func(T) bool) int {
 	return -1
 }
 
+// IndexFuncFrom behaves like IndexFunc but starts searching at index start, so repeated calls can find each match in
+// turn. A negative start is treated as 0. Returns -1 if no element at or after start matches.
+func (s *Slice[T]) IndexFuncFrom(start int, f func(T) bool) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for i := max(start, 0); i < len(s.Data); i++ {
+		if f(s.Data[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexFunc returns the index of the last element for which f returns true, or -1 if none do.
+func (s *Slice[T]) LastIndexFunc(f func(T) bool) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for i := len(s.Data) - 1; i >= 0; i-- {
+		if f(s.Data[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Map returns a new Slice holding the result of f for each element. f is applied to a snapshot taken under a single
+// lock, so the Slice is not locked while f runs.
+func (s *Slice[T]) Map(f func(T) T) *Slice[T] {
+	return MapSlice(s, f)
+}
+
+// MinFunc returns the minimal element according to cmp, in the manner of slices.MinFunc. The boolean is false if the
+// Slice is empty.
+func (s *Slice[T]) MinFunc(cmp func(a, b T) int) (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	return slices.MinFunc(s.Data, cmp), true
+}
+
+// MaxFunc returns the maximal element according to cmp, in the manner of slices.MaxFunc. The boolean is false if the
+// Slice is empty.
+func (s *Slice[T]) MaxFunc(cmp func(a, b T) int) (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(s.Data) == 0 {
+		return *new(T), false
+	}
+
+	return slices.MaxFunc(s.Data, cmp), true
+}
+
+// Count returns the number of elements for which f returns true, evaluated under a single lock.
+func (s *Slice[T]) Count(f func(T) bool) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	n := 0
+	for _, v := range s.Data {
+		if f(v) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Partition returns copies of the elements for which f returns true and of those for which it returns false, each in
+// their original order, computed under a single lock. The Slice is left unchanged.
+func (s *Slice[T]) Partition(f func(T) bool) (matched, unmatched []T) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, v := range s.Data {
+		if f(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// PartitionInPlace keeps only the elements for which f returns true and returns the others, in their original order,
+// under a single lock.
+func (s *Slice[T]) PartitionInPlace(f func(T) bool) (unmatched []T) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	s.Data = slices.DeleteFunc(s.Data, func(v T) bool {
+		if f(v) {
+			return false
+		}
+		unmatched = append(unmatched, v)
+
+		return true
+	})
+
+	return unmatched
+}
+
+// ContainsFunc reports whether f returns true for at least one element of the Slice.
+func (s *Slice[T]) ContainsFunc(f func(T) bool) bool {
+	return s.IndexFunc(f) >= 0
+}
+
+// Any reports whether f returns true for at least one element. It is equivalent to ContainsFunc.
+func (s *Slice[T]) Any(f func(T) bool) bool {
+	return s.ContainsFunc(f)
+}
+
+// Every reports whether f returns true for every element. It returns true for an empty Slice.
+func (s *Slice[T]) Every(f func(T) bool) bool {
+	return !s.ContainsFunc(func(v T) bool {
+		return !f(v)
+	})
+}
+
+// None reports whether f returns false for every element. It returns true for an empty Slice.
+func (s *Slice[T]) None(f func(T) bool) bool {
+	return !s.ContainsFunc(f)
+}
+
+// Equal reports whether the Slice and other have the same length and eq reports each pair of elements as equal. Both
+// Slices are locked for the duration of the comparison.
+func (s *Slice[T]) Equal(other *Slice[T], eq func(a, b T) bool) bool {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	return slices.EqualFunc(s.Data, other.Data, eq)
+}
+
+// CompareFunc compares the Slice and other element by element using cmp, in the manner of slices.CompareFunc. Both
+// Slices are locked for the duration of the comparison.
+func (s *Slice[T]) CompareFunc(other *Slice[T], cmp func(a, b T) int) int {
+	unlock := lockPair(s.lock.RLocker(), other.lock.RLocker())
+	defer unlock()
+
+	return slices.CompareFunc(s.Data, other.Data, cmp)
+}
+
 func (s *Slice[T]) Len() int {
-	s.lock.Lock()
-	defer s.lock.Unlock()
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 
 	return len(s.Data)
 }
+
+// Contains reports whether v is present in s.
+func Contains[T comparable](s *Slice[T], v T) bool {
+	return s.ContainsFunc(func(e T) bool {
+		return e == v
+	})
+}
+
+// MapSlice returns a new Slice holding the result of f for each element of s. It behaves like Slice.Map but may change
+// the element type.
+func MapSlice[T, U any](s *Slice[T], f func(T) U) *Slice[U] {
+	data := s.Snapshot()
+
+	mapped := make([]U, len(data))
+	for i, v := range data {
+		mapped[i] = f(v)
+	}
+
+	return &Slice[U]{Data: mapped}
+}
+
+// SlicesEqual is Slice.Equal for slices of comparable elements, using == for equality.
+func SlicesEqual[T comparable](a, b *Slice[T]) bool {
+	return a.Equal(b, equal[T])
+}
+
+// Reduce folds the elements of s into a single value, calling f with the running accumulator and each element in
+// order, starting from initial. The whole fold runs under a single lock, so f must not call methods on s.
+func Reduce[T, A any](s *Slice[T], initial A, f func(acc A, v T) A) A {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	acc := initial
+	for _, v := range s.Data {
+		acc = f(acc, v)
+	}
+
+	return acc
+}
+
+// Unique removes every duplicate element from s, keeping the first occurrence of each value in its original position.
+// Returns the number of elements removed.
+func Unique[T comparable](s *Slice[T]) int {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	seen := make(map[T]struct{}, len(s.Data))
+
+	n := len(s.Data)
+	s.Data = slices.DeleteFunc(s.Data, func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+
+		return false
+	})
+
+	return n - len(s.Data)
+}
+
+// Sum returns the sum of the elements of s, computed under a single lock.
+func Sum[T Number](s *Slice[T]) T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var sum T
+	for _, v := range s.Data {
+		sum += v
+	}
+
+	return sum
+}
+
+// Average returns the arithmetic mean of the elements of s as a float64, computed under a single lock. The boolean is
+// false if s is empty.
+func Average[T Number](s *Slice[T]) (float64, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if len(s.Data) == 0 {
+		return 0, false
+	}
+
+	var sum float64
+	for _, v := range s.Data {
+		sum += float64(v)
+	}
+
+	return sum / float64(len(s.Data)), true
+}
+
+// GroupBy buckets the elements of s by the key f returns for each, built from a single consistent snapshot. Elements
+// keep their original order within each group.
+func GroupBy[T any, K comparable](s *Slice[T], key func(T) K) *Map[K, *Slice[T]] {
+	groups := NewMap[K, *Slice[T]]()
+	for _, v := range s.Snapshot() {
+		k := key(v)
+
+		g, ok := groups.Data[k]
+		if !ok {
+			g = &Slice[T]{}
+			groups.Data[k] = g
+		}
+		g.Data = append(g.Data, v)
+	}
+
+	return groups
+}
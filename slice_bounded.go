@@ -0,0 +1,129 @@
+package threadsafe
+
+import "sync"
+
+// OverflowPolicy selects what a Slice does when adding elements would exceed its maximum length.
+type OverflowPolicy int
+
+const (
+	// OverflowReject rejects the whole append or insert, leaving the Slice unchanged.
+	OverflowReject OverflowPolicy = iota
+	// OverflowDropOldest adds the elements and then drops elements from the front until the Slice fits.
+	OverflowDropOldest
+	// OverflowBlock blocks until other goroutines remove enough elements to make room. Append adds one element at a
+	// time as space becomes available, while inserts wait until all of their elements fit at once, and are rejected if
+	// they could never fit.
+	OverflowBlock
+)
+
+type sliceBound struct {
+	max    int
+	policy OverflowPolicy
+	space  *sync.Cond
+}
+
+// SetMaxLen limits the number of elements the Slice may grow to, handling appends and inserts past the limit according
+// to policy. WithLock and UnmarshalJSON, which replace the contents wholesale, enforce the limit as documented on each.
+// With OverflowDropOldest, a Slice already longer than n is trimmed immediately; with the other policies existing
+// elements are kept. A limit of n <= 0 removes the limit.
+func (s *Slice[T]) SetMaxLen(n int, policy OverflowPolicy) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	// Wake any blocked Append so it re-checks against the new limit.
+	if s.bound != nil {
+		s.bound.space.Broadcast()
+	}
+
+	if n <= 0 {
+		s.bound = nil
+		return
+	}
+
+	s.bound = &sliceBound{max: n, policy: policy, space: sync.NewCond(&s.lock)}
+	if policy == OverflowDropOldest {
+		s.dropOldest()
+	}
+}
+
+// appendBounded appends v while respecting the Slice's maximum length. Returns false if the append was rejected. The
+// caller must hold the write lock.
+func (s *Slice[T]) appendBounded(v []T) bool {
+	b := s.bound
+
+	switch b.policy {
+	case OverflowReject:
+		if len(s.Data)+len(v) > b.max {
+			return false
+		}
+		s.Data = append(s.Data, v...)
+	case OverflowDropOldest:
+		s.Data = append(s.Data, v...)
+		s.dropOldest()
+	case OverflowBlock:
+		for i := range v {
+			// Re-read the bound after every wait, as SetMaxLen may have changed or removed it meanwhile.
+			for s.bound != nil && len(s.Data) >= s.bound.max {
+				s.bound.space.Wait()
+			}
+			s.Data = append(s.Data, v[i])
+		}
+	}
+
+	return true
+}
+
+// makeRoom prepares the Slice for inserting n elements, waiting for space under OverflowBlock. Returns false if the
+// insert must be rejected. As waiting releases the lock, callers must validate indexes only after makeRoom returns.
+// The caller must hold the write lock.
+func (s *Slice[T]) makeRoom(n int) bool {
+	b := s.bound
+	if b == nil {
+		return true
+	}
+
+	switch b.policy {
+	case OverflowReject:
+		return len(s.Data)+n <= b.max
+	case OverflowBlock:
+		if n > b.max {
+			return false
+		}
+		for s.bound != nil && len(s.Data)+n > s.bound.max {
+			s.bound.space.Wait()
+		}
+	}
+
+	return true
+}
+
+// fit trims the Slice to its maximum length after an insert, dropping from the front under OverflowDropOldest. The
+// caller must hold the write lock.
+func (s *Slice[T]) fit() {
+	if s.bound != nil && s.bound.policy == OverflowDropOldest {
+		s.dropOldest()
+	}
+}
+
+// dropOldest removes elements from the front until the Slice fits its maximum length. The caller must hold the write
+// lock.
+func (s *Slice[T]) dropOldest() {
+	if over := len(s.Data) - s.bound.max; over > 0 {
+		clear(s.Data[:over])
+		s.Data = s.Data[over:]
+	}
+}
+
+// lockWrite acquires the write lock. It must be released with unlockWrite.
+func (s *Slice[T]) lockWrite() {
+	s.lock.Lock()
+}
+
+// unlockWrite releases the write lock, first waking any Append or insert blocked waiting for space.
+func (s *Slice[T]) unlockWrite() {
+	if s.bound != nil {
+		s.bound.space.Broadcast()
+	}
+
+	s.lock.Unlock()
+}
@@ -0,0 +1,283 @@
+package threadsafe
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSliceMaxLenPolicies(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy OverflowPolicy
+		op     func(s *Slice[int]) bool
+		ok     bool
+		want   []int
+	}{
+		{
+			name:   "append reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.Append(4, 5)
+			},
+			ok:   false,
+			want: []int{1, 2, 3},
+		},
+		{
+			name:   "append drop",
+			policy: OverflowDropOldest,
+			op: func(s *Slice[int]) bool {
+				return s.Append(4, 5)
+			},
+			ok:   true,
+			want: []int{2, 3, 4, 5},
+		},
+		{
+			name:   "append fits",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.Append(4)
+			},
+			ok:   true,
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name:   "insert reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.Insert(0, 0) && s.Insert(0, 0)
+			},
+			ok:   false,
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name:   "insert drop",
+			policy: OverflowDropOldest,
+			op: func(s *Slice[int]) bool {
+				return s.Insert(3, 9) && s.Insert(3, 8)
+			},
+			ok:   true,
+			want: []int{2, 3, 8, 9},
+		},
+		{
+			name:   "safe insert reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.SafeInsert(1, 0) && s.SafeInsert(1, 0)
+			},
+			ok:   false,
+			want: []int{1, 0, 2, 3},
+		},
+		{
+			name:   "insert all reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.InsertAll(0, 5, 6, 7)
+			},
+			ok:   false,
+			want: []int{1, 2, 3},
+		},
+		{
+			name:   "insert all drop",
+			policy: OverflowDropOldest,
+			op: func(s *Slice[int]) bool {
+				return s.InsertAll(1, 5, 6)
+			},
+			ok:   true,
+			want: []int{5, 6, 2, 3},
+		},
+		{
+			name:   "insert all block too large",
+			policy: OverflowBlock,
+			op: func(s *Slice[int]) bool {
+				return s.InsertAll(0, 5, 6, 7, 8, 9)
+			},
+			ok:   false,
+			want: []int{1, 2, 3},
+		},
+		{
+			name:   "sorted insert reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.SortedInsert(0, cmp.Compare[int]) >= 0 && s.SortedInsert(0, cmp.Compare[int]) >= 0
+			},
+			ok:   false,
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name:   "with lock reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return s.WithLock(func(d []int) []int { return append(d, 4, 5) })
+			},
+			ok:   false,
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name:   "with lock drop",
+			policy: OverflowDropOldest,
+			op: func(s *Slice[int]) bool {
+				return s.WithLock(func(d []int) []int { return append(d, 4, 5) })
+			},
+			ok:   true,
+			want: []int{2, 3, 4, 5},
+		},
+		{
+			name:   "json reject",
+			policy: OverflowReject,
+			op: func(s *Slice[int]) bool {
+				return json.Unmarshal([]byte("[5,6,7,8,9]"), s) == nil
+			},
+			ok:   false,
+			want: []int{1, 2, 3},
+		},
+		{
+			name:   "json drop",
+			policy: OverflowDropOldest,
+			op: func(s *Slice[int]) bool {
+				return json.Unmarshal([]byte("[5,6,7,8,9]"), s) == nil
+			},
+			ok:   true,
+			want: []int{6, 7, 8, 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSliceFrom([]int{1, 2, 3})
+			s.SetMaxLen(4, tt.policy)
+
+			if ok := tt.op(s); ok != tt.ok {
+				t.Errorf("ok = %v, want %v", ok, tt.ok)
+			}
+			if !slices.Equal(s.Snapshot(), tt.want) {
+				t.Errorf("Data = %v, want %v", s.Snapshot(), tt.want)
+			}
+			if s.Len() > 4 {
+				t.Errorf("Len() = %d, exceeds the maximum of 4", s.Len())
+			}
+		})
+	}
+}
+
+// waitBlocked fails the test if done is closed within a short delay, i.e. if the operation did not block.
+func waitBlocked(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-done:
+		t.Fatal("operation did not block on a full Slice")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// waitDone fails the test if done is not closed in time, i.e. if the blocked operation was never woken.
+func waitDone(t *testing.T, done <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("blocked operation was not woken")
+	}
+}
+
+func TestSliceOverflowBlockWakeUp(t *testing.T) {
+	tests := []struct {
+		name string
+		add  func(s *Slice[int])
+		free func(s *Slice[int])
+		want []int
+	}{
+		{
+			name: "append woken by pop front",
+			add:  func(s *Slice[int]) { s.Append(3) },
+			free: func(s *Slice[int]) { s.PopFront() },
+			want: []int{2, 3},
+		},
+		{
+			name: "append woken by delete",
+			add:  func(s *Slice[int]) { s.Append(3) },
+			free: func(s *Slice[int]) { s.Delete(1) },
+			want: []int{1, 3},
+		},
+		{
+			name: "insert woken by pop",
+			add:  func(s *Slice[int]) { s.Insert(0, 3) },
+			free: func(s *Slice[int]) { s.Pop() },
+			want: []int{3, 1},
+		},
+		{
+			name: "insert all woken by empty",
+			add:  func(s *Slice[int]) { s.InsertAll(0, 3, 4) },
+			free: func(s *Slice[int]) { s.Empty() },
+			want: []int{3, 4},
+		},
+		{
+			name: "sorted insert woken by drain",
+			add:  func(s *Slice[int]) { s.SortedInsert(0, cmp.Compare[int]) },
+			free: func(s *Slice[int]) { s.Drain() },
+			want: []int{0},
+		},
+		{
+			name: "append woken by raising the limit",
+			add:  func(s *Slice[int]) { s.Append(3) },
+			free: func(s *Slice[int]) { s.SetMaxLen(3, OverflowBlock) },
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "append woken by removing the limit",
+			add:  func(s *Slice[int]) { s.Append(3, 4) },
+			free: func(s *Slice[int]) { s.SetMaxLen(0, OverflowBlock) },
+			want: []int{1, 2, 3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSliceFrom([]int{1, 2})
+			s.SetMaxLen(2, OverflowBlock)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				tt.add(s)
+			}()
+
+			waitBlocked(t, done)
+			tt.free(s)
+			waitDone(t, done)
+
+			if got := s.Snapshot(); !slices.Equal(got, tt.want) {
+				t.Errorf("Data = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSliceOverflowBlockAppendsAsSpaceFrees(t *testing.T) {
+	s := NewSlice[int]()
+	s.SetMaxLen(1, OverflowBlock)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Append(1, 2, 3)
+	}()
+
+	var got []int
+	for len(got) < 3 {
+		if v, ok := s.PopFront(); ok {
+			got = append(got, v)
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	waitDone(t, done)
+
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("received %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,38 @@
+package threadsafe
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// MarshalJSON implements json.Marshaler. The Slice is encoded as a plain JSON array under the lock.
+func (s *Slice[T]) MarshalJSON() ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return json.Marshal(s.Data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The Slice's contents are replaced by the decoded JSON array. If a maximum
+// length has been set with SetMaxLen, a longer array keeps only its last elements under OverflowDropOldest and is
+// otherwise an error. If decoding fails the Slice is left unchanged.
+func (s *Slice[T]) UnmarshalJSON(b []byte) error {
+	var data []T
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	if s.bound != nil && len(data) > s.bound.max {
+		if s.bound.policy != OverflowDropOldest {
+			return errors.New("threadsafe: JSON array exceeds Slice maximum length")
+		}
+		data = data[len(data)-s.bound.max:]
+	}
+
+	s.Data = data
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package threadsafe
+
+import "sync"
+
+// Subscribe returns a channel that receives every element appended to the Slice with Append, and a function that
+// stops the subscription and closes the channel. Like Map.Watch, elements are sent without blocking while the Slice is
+// locked, so if the channel's buffer is full the element is dropped.
+func (s *Slice[T]) Subscribe() (<-chan T, func()) {
+	s.lockWrite()
+	defer s.unlockWrite()
+
+	ch := make(chan T, watchBuffer)
+	if s.subscribers == nil {
+		s.subscribers = make(map[chan T]struct{})
+	}
+	s.subscribers[ch] = struct{}{}
+
+	var once sync.Once
+
+	return ch, func() {
+		once.Do(func() {
+			s.lockWrite()
+			defer s.unlockWrite()
+
+			delete(s.subscribers, ch)
+			close(ch)
+		})
+	}
+}
+
+// publish sends each of vs to every subscriber. The caller must hold the write lock.
+func (s *Slice[T]) publish(vs []T) {
+	for ch := range s.subscribers {
+		for _, v := range vs {
+			select {
+			case ch <- v:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package threadsafe
+
+import (
+	"cmp"
+	"sync"
+)
+
+// SortedMap represents a generic map that keeps its keys in ascending order. It is backed by a balanced tree, so
+// lookups and writes run in O(log n) and ordered queries such as Floor, Ceiling and RangeBetween are cheap.
+type SortedMap[K cmp.Ordered, V any] struct {
+	tree treap[K, V]
+	lock sync.RWMutex
+}
+
+func NewSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{}
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if the value was found or not.
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return nodeEntry(m.tree.find(key))
+}
+
+// Set writes the value V at key K.
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tree.set(key, value)
+}
+
+// Delete deletes the key K, if it exists.
+func (m *SortedMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tree.delete(key)
+}
+
+// Min returns the entry with the smallest key. The boolean is false if the map is empty.
+func (m *SortedMap[K, V]) Min() (K, V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return nodeItem(m.tree.root.min())
+}
+
+// Max returns the entry with the largest key. The boolean is false if the map is empty.
+func (m *SortedMap[K, V]) Max() (K, V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return nodeItem(m.tree.root.max())
+}
+
+// Floor returns the entry with the largest key less than or equal to key. The boolean is false if there is none.
+func (m *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return nodeItem(m.tree.floor(key))
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to key. The boolean is false if there is none.
+func (m *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return nodeItem(m.tree.ceiling(key))
+}
+
+// Range calls f sequentially for each key and value in ascending key order. If f returns false, Range stops the
+// iteration. The map is locked for the duration of the call, so f must not call other methods on the map.
+func (m *SortedMap[K, V]) Range(f func(key K, value V) bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	m.tree.root.each(f)
+}
+
+// RangeBetween behaves like Range but only visits keys in the inclusive range [lo, hi].
+func (m *SortedMap[K, V]) RangeBetween(lo, hi K, f func(key K, value V) bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	m.tree.root.between(lo, hi, f)
+}
+
+// Keys returns a slice of K keys in ascending order.
+func (m *SortedMap[K, V]) Keys() []K {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]K, 0, m.tree.root.len())
+	m.tree.root.each(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+
+	return keys
+}
+
+// Values returns a slice of V values in ascending key order.
+func (m *SortedMap[K, V]) Values() []V {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	values := make([]V, 0, m.tree.root.len())
+	m.tree.root.each(func(_ K, v V) bool {
+		values = append(values, v)
+		return true
+	})
+
+	return values
+}
+
+// Empty deletes all keys in the map.
+func (m *SortedMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.tree.root = nil
+}
+
+// Len returns the length of the map.
+func (m *SortedMap[K, V]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.tree.root.len()
+}
+
+func nodeEntry[K cmp.Ordered, V any](n *treapNode[K, V]) (V, bool) {
+	if n == nil {
+		return *new(V), false
+	}
+
+	return n.value, true
+}
+
+func nodeItem[K cmp.Ordered, V any](n *treapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		return *new(K), *new(V), false
+	}
+
+	return n.key, n.value, true
+}
@@ -0,0 +1,136 @@
+package threadsafe
+
+import (
+	"cmp"
+	"sync"
+)
+
+// SortedSet represents a generic set that keeps its members in ascending order. It is backed by a balanced tree, so
+// membership checks and writes run in O(log n), as do rank queries such as Rank and Select. The zero value is an empty
+// set ready to use.
+type SortedSet[T cmp.Ordered] struct {
+	tree treap[T, struct{}]
+	lock sync.RWMutex
+}
+
+func NewSortedSet[T cmp.Ordered]() *SortedSet[T] {
+	return &SortedSet[T]{}
+}
+
+// Add adds the values v to the set.
+func (s *SortedSet[T]) Add(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, e := range v {
+		s.tree.set(e, struct{}{})
+	}
+}
+
+// Remove removes the values v from the set, if present.
+func (s *SortedSet[T]) Remove(v ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, e := range v {
+		s.tree.delete(e)
+	}
+}
+
+// Contains reports whether v is in the set.
+func (s *SortedSet[T]) Contains(v T) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.tree.find(v) != nil
+}
+
+// Min returns the smallest member. The boolean is false if the set is empty.
+func (s *SortedSet[T]) Min() (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	v, _, ok := nodeItem(s.tree.root.min())
+
+	return v, ok
+}
+
+// Max returns the largest member. The boolean is false if the set is empty.
+func (s *SortedSet[T]) Max() (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	v, _, ok := nodeItem(s.tree.root.max())
+
+	return v, ok
+}
+
+// Rank returns the number of members less than v, which is the zero-based position v has or would have in the set.
+func (s *SortedSet[T]) Rank(v T) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.tree.rank(v)
+}
+
+// Select returns the member at the zero-based position i in ascending order. The boolean is false if i is out of
+// range.
+func (s *SortedSet[T]) Select(i int) (T, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	v, _, ok := nodeItem(s.tree.at(i))
+
+	return v, ok
+}
+
+// Range calls f sequentially for each member in ascending order. If f returns false, Range stops the iteration. The
+// set is locked for the duration of the call, so f must not call other methods on the set.
+func (s *SortedSet[T]) Range(f func(v T) bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	s.tree.root.each(func(v T, _ struct{}) bool {
+		return f(v)
+	})
+}
+
+// RangeBetween behaves like Range but only visits members in the inclusive range [lo, hi].
+func (s *SortedSet[T]) RangeBetween(lo, hi T, f func(v T) bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	s.tree.root.between(lo, hi, func(v T, _ struct{}) bool {
+		return f(v)
+	})
+}
+
+// ToSlice returns the members of the set in ascending order.
+func (s *SortedSet[T]) ToSlice() []T {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	values := make([]T, 0, s.tree.root.len())
+	s.tree.root.each(func(v T, _ struct{}) bool {
+		values = append(values, v)
+		return true
+	})
+
+	return values
+}
+
+// Empty removes every member of the set.
+func (s *SortedSet[T]) Empty() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.tree.root = nil
+}
+
+// Len returns the number of members in the set.
+func (s *SortedSet[T]) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.tree.root.len()
+}
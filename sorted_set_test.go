@@ -0,0 +1,59 @@
+package threadsafe
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSortedSetNaN(t *testing.T) {
+	s := NewSortedSet[float64]()
+	s.Add(1, 2, 3, math.NaN(), math.NaN())
+
+	if s.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", s.Len())
+	}
+	if !s.Contains(math.NaN()) || !s.Contains(2) {
+		t.Errorf("Contains(NaN), Contains(2) = %v, %v, want true, true", s.Contains(math.NaN()), s.Contains(2))
+	}
+	if v, ok := s.Min(); !ok || !math.IsNaN(v) {
+		t.Errorf("Min() = %v, %v, want NaN, true", v, ok)
+	}
+	if r := s.Rank(1); r != 1 {
+		t.Errorf("Rank(1) = %d, want 1", r)
+	}
+	if v, ok := s.Select(1); !ok || v != 1 {
+		t.Errorf("Select(1) = %v, %v, want 1, true", v, ok)
+	}
+
+	s.Remove(math.NaN())
+	if got := s.ToSlice(); !slices.Equal(got, []float64{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestSortedSetRankSelect(t *testing.T) {
+	s := NewSortedSet[string]()
+	s.Add("carol", "alice", "dave", "bob")
+
+	for i, want := range []string{"alice", "bob", "carol", "dave"} {
+		if v, ok := s.Select(i); !ok || v != want {
+			t.Errorf("Select(%d) = %q, %v, want %q, true", i, v, ok, want)
+		}
+		if r := s.Rank(want); r != i {
+			t.Errorf("Rank(%q) = %d, want %d", want, r, i)
+		}
+	}
+	if _, ok := s.Select(4); ok {
+		t.Error("Select(4) found a member past the end")
+	}
+
+	var between []string
+	s.RangeBetween("b", "d", func(v string) bool {
+		between = append(between, v)
+		return true
+	})
+	if !slices.Equal(between, []string{"bob", "carol"}) {
+		t.Errorf("RangeBetween(b, d) = %v, want [bob carol]", between)
+	}
+}
@@ -0,0 +1,45 @@
+package threadsafe
+
+// The methods in this file mirror the method set of sync.Map, so a *Map can replace a sync.Map without renaming call
+// sites. Each is a thin alias for the equivalent Map method.
+
+// Load is an alias for Get.
+func (m *Map[K, V]) Load(key K) (value V, ok bool) {
+	return m.Get(key)
+}
+
+// Store is an alias for Set.
+func (m *Map[K, V]) Store(key K, value V) {
+	m.Set(key, value)
+}
+
+// LoadOrStore is an alias for GetOrSet.
+func (m *Map[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	return m.GetOrSet(key, value)
+}
+
+// LoadAndDelete is an alias for Pull.
+func (m *Map[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	return m.Pull(key)
+}
+
+// CompareAndSwap swaps the value at key K for new if its current value equals old. Like sync.Map, it panics if the
+// values are not comparable. Use CompareAndSwapFunc to supply a custom equality.
+func (m *Map[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	return m.CompareAndSwapFunc(key, old, new, equalAny[V])
+}
+
+// CompareAndDelete deletes the key K if its current value equals old. Like sync.Map, it panics if the values are not
+// comparable. Use CompareAndDeleteFunc to supply a custom equality.
+func (m *Map[K, V]) CompareAndDelete(key K, old V) (deleted bool) {
+	return m.CompareAndDeleteFunc(key, old, equalAny[V])
+}
+
+// Clear is an alias for Empty.
+func (m *Map[K, V]) Clear() {
+	m.Empty()
+}
+
+func equalAny[T any](a, b T) bool {
+	return any(a) == any(b)
+}
@@ -0,0 +1,247 @@
+package threadsafe
+
+import (
+	"cmp"
+	"math/rand/v2"
+)
+
+// treap is a randomized balanced binary search tree, ordered by key and heap-ordered by a random priority. Each node
+// tracks the size of its subtree so that rank and select queries run in O(log n). Keys are compared with cmp.Compare,
+// so a NaN key is a single distinct key ordered before every other float. A treap is not safe for concurrent use; the
+// types built on it do their own locking.
+type treap[K cmp.Ordered, V any] struct {
+	root *treapNode[K, V]
+}
+
+type treapNode[K cmp.Ordered, V any] struct {
+	key         K
+	value       V
+	priority    uint64
+	size        int
+	left, right *treapNode[K, V]
+}
+
+func (n *treapNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *treapNode[K, V]) update() {
+	n.size = 1 + n.left.len() + n.right.len()
+}
+
+// treapSplit splits n into the nodes with keys less than key and the nodes with keys greater than or equal to key.
+func treapSplit[K cmp.Ordered, V any](n *treapNode[K, V], key K) (lt, ge *treapNode[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if cmp.Less(n.key, key) {
+		n.right, ge = treapSplit(n.right, key)
+		n.update()
+		return n, ge
+	}
+
+	lt, n.left = treapSplit(n.left, key)
+	n.update()
+
+	return lt, n
+}
+
+// treapMerge joins a and b, where every key in a is less than every key in b.
+func treapMerge[K cmp.Ordered, V any](a, b *treapNode[K, V]) *treapNode[K, V] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if a.priority > b.priority {
+		a.right = treapMerge(a.right, b)
+		a.update()
+		return a
+	}
+
+	b.left = treapMerge(a, b.left)
+	b.update()
+
+	return b
+}
+
+func (t *treap[K, V]) find(key K) *treapNode[K, V] {
+	n := t.root
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+
+	return nil
+}
+
+// set inserts or updates key. Returns true if the key was newly inserted.
+func (t *treap[K, V]) set(key K, value V) bool {
+	if n := t.find(key); n != nil {
+		n.value = value
+		return false
+	}
+
+	lt, ge := treapSplit(t.root, key)
+	n := &treapNode[K, V]{key: key, value: value, priority: rand.Uint64(), size: 1}
+	t.root = treapMerge(treapMerge(lt, n), ge)
+
+	return true
+}
+
+// delete removes key. Returns the removed node, or nil if the key did not exist.
+func (t *treap[K, V]) delete(key K) *treapNode[K, V] {
+	n := t.find(key)
+	if n == nil {
+		return nil
+	}
+
+	t.root = treapRemove(t.root, key)
+
+	return n
+}
+
+// treapRemove removes key from the subtree rooted at n, which must contain it, and returns the new root.
+func treapRemove[K cmp.Ordered, V any](n *treapNode[K, V], key K) *treapNode[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = treapRemove(n.left, key)
+	case c > 0:
+		n.right = treapRemove(n.right, key)
+	default:
+		return treapMerge(n.left, n.right)
+	}
+
+	n.update()
+
+	return n
+}
+
+func (n *treapNode[K, V]) min() *treapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+func (n *treapNode[K, V]) max() *treapNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
+// floor returns the node with the greatest key less than or equal to key, or nil.
+func (t *treap[K, V]) floor(key K) *treapNode[K, V] {
+	var best *treapNode[K, V]
+	for n := t.root; n != nil; {
+		if cmp.Compare(n.key, key) <= 0 {
+			best = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return best
+}
+
+// ceiling returns the node with the smallest key greater than or equal to key, or nil.
+func (t *treap[K, V]) ceiling(key K) *treapNode[K, V] {
+	var best *treapNode[K, V]
+	for n := t.root; n != nil; {
+		if cmp.Compare(n.key, key) >= 0 {
+			best = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return best
+}
+
+// rank returns the number of keys less than key.
+func (t *treap[K, V]) rank(key K) int {
+	r := 0
+	for n := t.root; n != nil; {
+		if cmp.Less(n.key, key) {
+			r += n.left.len() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return r
+}
+
+// at returns the node with the given zero-based rank, or nil if it is out of range.
+func (t *treap[K, V]) at(i int) *treapNode[K, V] {
+	if i < 0 || i >= t.root.len() {
+		return nil
+	}
+
+	n := t.root
+	for {
+		l := n.left.len()
+		switch {
+		case i < l:
+			n = n.left
+		case i > l:
+			i -= l + 1
+			n = n.right
+		default:
+			return n
+		}
+	}
+}
+
+// between calls f in key order for each node with a key in [lo, hi]. It returns false if f stopped the iteration.
+func (n *treapNode[K, V]) between(lo, hi K, f func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if cmp.Less(lo, n.key) && !n.left.between(lo, hi, f) {
+		return false
+	}
+	if cmp.Compare(lo, n.key) <= 0 && cmp.Compare(n.key, hi) <= 0 && !f(n.key, n.value) {
+		return false
+	}
+	if cmp.Less(n.key, hi) {
+		return n.right.between(lo, hi, f)
+	}
+
+	return true
+}
+
+// each calls f in key order for every node. It returns false if f stopped the iteration.
+func (n *treapNode[K, V]) each(f func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return n.left.each(f) && f(n.key, n.value) && n.right.each(f)
+}
@@ -0,0 +1,158 @@
+package threadsafe
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// treapKeys collects the keys of t in order through each.
+func treapKeys[K cmp.Ordered, V any](t *treap[K, V]) []K {
+	var keys []K
+	t.root.each(func(k K, _ V) bool {
+		keys = append(keys, k)
+		return true
+	})
+
+	return keys
+}
+
+func TestTreapQueries(t *testing.T) {
+	var tr treap[int, string]
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		tr.set(k, "")
+	}
+
+	tests := []struct {
+		key     int
+		rank    int
+		floor   int
+		ceiling int
+	}{
+		{key: 5, rank: 0, floor: -1, ceiling: 10},
+		{key: 10, rank: 0, floor: 10, ceiling: 10},
+		{key: 25, rank: 2, floor: 20, ceiling: 30},
+		{key: 50, rank: 4, floor: 50, ceiling: 50},
+		{key: 55, rank: 5, floor: 50, ceiling: -1},
+	}
+
+	for _, tt := range tests {
+		if got := tr.rank(tt.key); got != tt.rank {
+			t.Errorf("rank(%d) = %d, want %d", tt.key, got, tt.rank)
+		}
+		if got := nodeKey(tr.floor(tt.key)); got != tt.floor {
+			t.Errorf("floor(%d) = %d, want %d", tt.key, got, tt.floor)
+		}
+		if got := nodeKey(tr.ceiling(tt.key)); got != tt.ceiling {
+			t.Errorf("ceiling(%d) = %d, want %d", tt.key, got, tt.ceiling)
+		}
+	}
+
+	for i, want := range []int{10, 20, 30, 40, 50} {
+		if got := nodeKey(tr.at(i)); got != want {
+			t.Errorf("at(%d) = %d, want %d", i, got, want)
+		}
+	}
+	for _, i := range []int{-1, 5} {
+		if n := tr.at(i); n != nil {
+			t.Errorf("at(%d) = %d, want nil", i, n.key)
+		}
+	}
+}
+
+func nodeKey(n *treapNode[int, string]) int {
+	if n == nil {
+		return -1
+	}
+
+	return n.key
+}
+
+func TestTreapMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	keys := []float64{math.NaN(), math.Inf(-1), -1, 0, 0.5, 1, 2, 3, math.Inf(1)}
+
+	var tr treap[float64, int]
+	var model []float64 // sorted by cmp.Compare, without duplicates
+
+	for range 5000 {
+		k := keys[r.IntN(len(keys))]
+		i, found := slices.BinarySearchFunc(model, k, cmp.Compare[float64])
+
+		if r.IntN(3) == 0 {
+			if got := tr.delete(k) != nil; got != found {
+				t.Fatalf("delete(%v) = %v, want %v", k, got, found)
+			}
+			if found {
+				model = slices.Delete(model, i, i+1)
+			}
+		} else {
+			if got := tr.set(k, 0); got == found {
+				t.Fatalf("set(%v) = %v, want %v", k, got, !found)
+			}
+			if !found {
+				model = slices.Insert(model, i, k)
+			}
+		}
+
+		if got := treapKeys(&tr); !slices.EqualFunc(got, model, func(a, b float64) bool { return cmp.Compare(a, b) == 0 }) {
+			t.Fatalf("keys = %v, want %v", got, model)
+		}
+		if tr.root.len() != len(model) {
+			t.Fatalf("len = %d, want %d", tr.root.len(), len(model))
+		}
+
+		q := keys[r.IntN(len(keys))]
+		rank, _ := slices.BinarySearchFunc(model, q, cmp.Compare[float64])
+		if got := tr.rank(q); got != rank {
+			t.Fatalf("rank(%v) = %d, want %d", q, got, rank)
+		}
+		if (tr.find(q) != nil) != (rank < len(model) && cmp.Compare(model[rank], q) == 0) {
+			t.Fatalf("find(%v) disagrees with model %v", q, model)
+		}
+
+		for i, want := range model {
+			if n := tr.at(i); n == nil || cmp.Compare(n.key, want) != 0 {
+				t.Fatalf("at(%d) = %v, want %v", i, n, want)
+			}
+		}
+
+		lo, hi := keys[r.IntN(len(keys))], keys[r.IntN(len(keys))]
+		var got, want []float64
+		tr.root.between(lo, hi, func(k float64, _ int) bool {
+			got = append(got, k)
+			return true
+		})
+		for _, k := range model {
+			if cmp.Compare(lo, k) <= 0 && cmp.Compare(k, hi) <= 0 {
+				want = append(want, k)
+			}
+		}
+		if !slices.EqualFunc(got, want, func(a, b float64) bool { return cmp.Compare(a, b) == 0 }) {
+			t.Fatalf("between(%v, %v) = %v, want %v", lo, hi, got, want)
+		}
+	}
+}
+
+func TestSortedMapNaNKey(t *testing.T) {
+	m := NewSortedMap[float64, string]()
+	m.Set(1, "one")
+	m.Set(2, "two")
+	m.Set(3, "three")
+	m.Set(math.NaN(), "nan")
+
+	if m.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", m.Len())
+	}
+	if got := m.Values(); !slices.Equal(got, []string{"nan", "one", "two", "three"}) {
+		t.Errorf("Values() = %v, want [nan one two three]", got)
+	}
+	if v, ok := m.Get(math.NaN()); !ok || v != "nan" {
+		t.Errorf("Get(NaN) = %q, %v, want \"nan\", true", v, ok)
+	}
+	if v, ok := m.Get(2); !ok || v != "two" {
+		t.Errorf("Get(2) = %q, %v, want \"two\", true", v, ok)
+	}
+}
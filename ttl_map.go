@@ -0,0 +1,182 @@
+package threadsafe
+
+import (
+	"sync"
+	"time"
+)
+
+// TTLMap represents a generic map whose entries expire after a time-to-live. Expired entries are removed lazily when
+// they are accessed, and optionally by a background janitor goroutine. A TTLMap with a janitor must be closed with
+// Close to stop the goroutine.
+type TTLMap[K comparable, V any] struct {
+	data    map[K]ttlEntry[V]
+	ttl     time.Duration
+	onEvict func(K, V, EvictionReason)
+	lock    sync.Mutex
+	janitor janitor
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// expired reports whether the entry has expired at time now. Entries with a zero expiry never expire.
+func (e ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// NewTTLMap returns a TTLMap whose entries expire ttl after they are set. A ttl <= 0 means entries never expire unless
+// set with SetWithTTL.
+func NewTTLMap[K comparable, V any](ttl time.Duration) *TTLMap[K, V] {
+	return &TTLMap[K, V]{
+		data: make(map[K]ttlEntry[V]),
+		ttl:  ttl,
+	}
+}
+
+// NewTTLMapWithJanitor behaves like NewTTLMap but also starts a goroutine that removes expired entries every interval.
+// Close must be called to stop it. NewTTLMapWithJanitor will panic if interval is not positive.
+func NewTTLMapWithJanitor[K comparable, V any](ttl time.Duration, interval time.Duration) *TTLMap[K, V] {
+	m := NewTTLMap[K, V](ttl)
+	m.janitor.start(interval, func() { m.DeleteExpired() })
+
+	return m
+}
+
+// Get returns the value V at key K. Also returns a boolean representing if an unexpired value was found or not.
+func (m *TTLMap[K, V]) Get(key K) (V, bool) {
+	m.lock.Lock()
+
+	e, ok := m.data[key]
+	if !ok {
+		m.lock.Unlock()
+		return *new(V), false
+	}
+
+	if e.expired(time.Now()) {
+		delete(m.data, key)
+		onEvict := m.onEvict
+		m.lock.Unlock()
+
+		if onEvict != nil {
+			onEvict(key, e.value, EvictedExpired)
+		}
+
+		return *new(V), false
+	}
+
+	m.lock.Unlock()
+
+	return e.value, true
+}
+
+// Set writes the value V at key K using the map's default ttl.
+func (m *TTLMap[K, V]) Set(key K, value V) {
+	m.SetWithTTL(key, value, m.ttl)
+}
+
+// SetWithTTL writes the value V at key K, expiring after ttl. A ttl <= 0 means the entry never expires.
+func (m *TTLMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e := ttlEntry[V]{value: value}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+
+	m.data[key] = e
+}
+
+// Delete deletes the key K, if it exists.
+func (m *TTLMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.data, key)
+}
+
+// DeleteExpired deletes every expired entry. Returns the number of entries deleted.
+func (m *TTLMap[K, V]) DeleteExpired() int {
+	m.lock.Lock()
+
+	now := time.Now()
+
+	var expired []entry[K, V]
+	for k, e := range m.data {
+		if e.expired(now) {
+			delete(m.data, k)
+			expired = append(expired, entry[K, V]{key: k, value: e.value})
+		}
+	}
+
+	onEvict := m.onEvict
+	m.lock.Unlock()
+
+	if onEvict != nil {
+		for _, e := range expired {
+			onEvict(e.key, e.value, EvictedExpired)
+		}
+	}
+
+	return len(expired)
+}
+
+// OnEvict registers f to be called whenever an expired entry is removed, either lazily or by the janitor. f is called
+// after the map has been unlocked, so it may safely call methods on the map. Calling OnEvict again replaces the
+// previous callback, and a nil f removes it.
+func (m *TTLMap[K, V]) OnEvict(f func(key K, value V, reason EvictionReason)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.onEvict = f
+}
+
+// Keys returns a slice of the unexpired K keys.
+func (m *TTLMap[K, V]) Keys() []K {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+
+	keys := make([]K, 0, len(m.data))
+	for k, e := range m.data {
+		if !e.expired(now) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// Empty deletes all keys in the map.
+func (m *TTLMap[K, V]) Empty() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.data = make(map[K]ttlEntry[V])
+}
+
+// Len returns the number of unexpired entries in the map.
+func (m *TTLMap[K, V]) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+
+	n := 0
+	for _, e := range m.data {
+		if !e.expired(now) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Close stops the janitor goroutine, if one was started. It is safe to call Close more than once. The map remains
+// usable after Close, relying on lazy expiration only.
+func (m *TTLMap[K, V]) Close() {
+	m.janitor.stop()
+}
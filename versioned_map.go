@@ -0,0 +1,107 @@
+package threadsafe
+
+import "sync"
+
+// VersionedMap represents a generic map where every entry carries a version, for optimistic concurrency. Each write
+// assigns the entry a new version from a counter shared by the whole map, so versions only ever increase and a key
+// that is deleted and set again never reuses an old version. A caller reads a value and its version with Get, then
+// writes with SetVersion, which fails if anyone else wrote the key in between.
+type VersionedMap[K comparable, V any] struct {
+	data    map[K]versioned[V]
+	version uint64
+	lock    sync.RWMutex
+}
+
+type versioned[V any] struct {
+	value   V
+	version uint64
+}
+
+func NewVersionedMap[K comparable, V any]() *VersionedMap[K, V] {
+	return &VersionedMap[K, V]{
+		data: make(map[K]versioned[V]),
+	}
+}
+
+// Get returns the value V at key K and its current version. Also returns a boolean representing if the value was
+// found or not.
+func (m *VersionedMap[K, V]) Get(key K) (V, uint64, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	e, ok := m.data[key]
+
+	return e.value, e.version, ok
+}
+
+// Set unconditionally writes the value V at key K and returns its new version.
+func (m *VersionedMap[K, V]) Set(key K, value V) uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.set(key, value)
+}
+
+// SetVersion writes the value V at key K only if the key's current version is version. A version of 0 means the key
+// must not exist. Returns the new version and true on success, or the current version and false if it did not match.
+func (m *VersionedMap[K, V]) SetVersion(key K, value V, version uint64) (uint64, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if current := m.data[key].version; current != version {
+		return current, false
+	}
+
+	return m.set(key, value), true
+}
+
+func (m *VersionedMap[K, V]) set(key K, value V) uint64 {
+	m.version++
+	m.data[key] = versioned[V]{value: value, version: m.version}
+
+	return m.version
+}
+
+// Delete deletes the key K, if it exists.
+func (m *VersionedMap[K, V]) Delete(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.data, key)
+}
+
+// DeleteVersion deletes the key K only if its current version is version. Returns true if the key was deleted.
+func (m *VersionedMap[K, V]) DeleteVersion(key K, version uint64) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e, ok := m.data[key]
+	if !ok || e.version != version {
+		return false
+	}
+
+	delete(m.data, key)
+
+	return true
+}
+
+// Keys returns a slice of K keys.
+func (m *VersionedMap[K, V]) Keys() []K {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	keys := make([]K, 0, len(m.data))
+	for k := range m.data {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Len returns the length of the map.
+func (m *VersionedMap[K, V]) Len() int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return len(m.data)
+}